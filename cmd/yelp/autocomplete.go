@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// runAutocomplete makes an Autocomplete request.
+func runAutocomplete(ctx context.Context, client yelp.Client, args []string) (interface{}, error) {
+	options, err := parseAutocompleteFlags(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	ec, err := extendedClient(client, "autocomplete")
+	if err != nil {
+		return nil, err
+	}
+	return ec.Autocomplete(ctx, options)
+}
+
+// parseAutocompleteFlags parses the flags of the autocomplete command into
+// AutocompleteOptions.
+func parseAutocompleteFlags(args []string) (*yelp.AutocompleteOptions, error) {
+	fs := flag.NewFlagSet("autocomplete", flag.ContinueOnError)
+	text := fs.String("text", "", "text to complete")
+	latitude := fs.Float64("latitude", 0, "latitude to suggest businesses near")
+	longitude := fs.Float64("longitude", 0, "longitude to suggest businesses near")
+	locale := fs.String("locale", "", "locale of the suggestions, ie. en_US")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *text == "" && fs.NArg() > 0 {
+		*text = fs.Arg(0)
+	}
+
+	set := setFlags(fs)
+	options := &yelp.AutocompleteOptions{Text: *text}
+	if set["latitude"] || set["longitude"] {
+		options.Coordinates = &yelp.Coordinates{
+			Latitude:  *latitude,
+			Longitude: *longitude,
+		}
+	}
+	if set["locale"] {
+		options.Locale = locale
+	}
+	return options, nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// runBusiness makes a Get Business request.
+func runBusiness(ctx context.Context, client yelp.Client, args []string) (interface{}, error) {
+	options, err := parseBusinessFlags(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	return client.GetBusiness(ctx, options)
+}
+
+// parseBusinessFlags parses the flags of the business command into
+// GetBusinessOptions.
+func parseBusinessFlags(args []string) (*yelp.GetBusinessOptions, error) {
+	fs := flag.NewFlagSet("business", flag.ContinueOnError)
+	id := fs.String("id", "", "business ID or alias")
+	locale := fs.String("locale", "", "locale of the returned business, ie. en_US")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *id == "" && fs.NArg() > 0 {
+		*id = fs.Arg(0)
+	}
+
+	set := setFlags(fs)
+	options := &yelp.GetBusinessOptions{ID: *id}
+	if set["locale"] {
+		options.Locale = locale
+	}
+	return options, nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// runCategories makes an All Categories request, or a Category Details request
+// when an alias is given.
+func runCategories(ctx context.Context, client yelp.Client, args []string) (interface{}, error) {
+	all, one, err := parseCategoriesFlags(args)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := extendedClient(client, "categories")
+	if err != nil {
+		return nil, err
+	}
+	if one != nil {
+		if err := one.Validate(); err != nil {
+			return nil, err
+		}
+		return ec.GetCategory(ctx, one)
+	}
+	if err := all.Validate(); err != nil {
+		return nil, err
+	}
+	return ec.Categories(ctx, all)
+}
+
+// parseCategoriesFlags parses the flags of the categories command into
+// CategoriesOptions, or GetCategoryOptions when an alias is given.
+func parseCategoriesFlags(args []string) (*yelp.CategoriesOptions, *yelp.GetCategoryOptions, error) {
+	fs := flag.NewFlagSet("categories", flag.ContinueOnError)
+	alias := fs.String("alias", "", "alias of a single category to get, ie. hotdogs")
+	locale := fs.String("locale", "", "locale of the category titles, ie. en_US")
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, err
+	}
+	if *alias == "" && fs.NArg() > 0 {
+		*alias = fs.Arg(0)
+	}
+
+	set := setFlags(fs)
+	var localePointer *string
+	if set["locale"] {
+		localePointer = locale
+	}
+	if *alias != "" {
+		return nil, &yelp.GetCategoryOptions{Alias: *alias, Locale: localePointer}, nil
+	}
+	return &yelp.CategoriesOptions{Locale: localePointer}, nil, nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flag"
+	"strings"
+)
+
+// stringsFlag is a flag containing a comma separated list of values.
+type stringsFlag []string
+
+// String implements the flag.Value interface.
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+// Set implements the flag.Value interface.
+func (s *stringsFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*s = append(*s, v)
+		}
+	}
+	return nil
+}
+
+// setFlags returns the names of the flags that were provided to fs, so that
+// unset flags can be left out of the request options.
+func setFlags(fs *flag.FlagSet) map[string]bool {
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	return set
+}
@@ -0,0 +1,181 @@
+// Command yelp makes requests to the Yelp Fusion API from the command line.
+//
+// Usage:
+//
+//	yelp [-config file] [-format json|jsonl|table|csv|geojson|kml] [-columns id,name,...] <command> [flags]
+//
+// The API key is read from the YELP_API_KEY environment variable, or from the
+// "api_key" field of a JSON config file, which defaults to
+// $XDG_CONFIG_HOME/yelp/config.json.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/alex-chou/go-yelp/output"
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// command is a subcommand of the CLI.
+type command struct {
+	usage string
+	run   func(ctx context.Context, client yelp.Client, args []string) (interface{}, error)
+}
+
+// commands are the available subcommands, keyed by name.
+var commands = map[string]command{
+	"search": {
+		usage: "Search for businesses with the Business Search API.",
+		run:   runSearch,
+	},
+	"business": {
+		usage: "Get business details with the Get Business API.",
+		run:   runBusiness,
+	},
+	"reviews": {
+		usage: "Get review excerpts of a business with the Reviews API.",
+		run:   runReviews,
+	},
+	"match": {
+		usage: "Find businesses by name and address with the Business Match API.",
+		run:   runMatch,
+	},
+	"phone": {
+		usage: "Find businesses by phone number with the Phone Search API.",
+		run:   runPhone,
+	},
+	"autocomplete": {
+		usage: "Suggest terms, businesses and categories with the Autocomplete API.",
+		run:   runAutocomplete,
+	},
+	"categories": {
+		usage: "List categories, or get one by alias, with the Categories API.",
+		run:   runCategories,
+	},
+}
+
+// extendedClient returns client as a yelp.ExtendedClient, or an error if it
+// cannot make the requests of the named command.
+func extendedClient(client yelp.Client, name string) (yelp.ExtendedClient, error) {
+	ec, ok := client.(yelp.ExtendedClient)
+	if !ok {
+		return nil, fmt.Errorf("%s requests are not supported by %T", name, client)
+	}
+	return ec, nil
+}
+
+// config is the format of the config file.
+type config struct {
+	APIKey string `json:"api_key"`
+}
+
+func main() {
+	if err := run(context.Background(), os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(2)
+		}
+		os.Exit(1)
+	}
+}
+
+// run parses the global flags and runs the requested command, writing its
+// results to w.
+func run(ctx context.Context, args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("yelp", flag.ContinueOnError)
+	configPath := fs.String("config", defaultConfigPath(), "path to a JSON config file containing an `api_key`")
+	format := fs.String("format", string(output.FormatJSON), "output format: json, jsonl, table, csv, geojson or kml")
+	var columnNames stringsFlag
+	fs.Var(&columnNames, "columns", "comma separated columns of the table and csv formats, ie. \"id,name,rating\"")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: yelp [flags] <command> [command flags]\n\nCommands:\n")
+		names := make([]string, 0, len(commands))
+		for name := range commands {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(fs.Output(), "  %-13s %s\n", name, commands[name].usage)
+		}
+		fmt.Fprintf(fs.Output(), "\nFlags:\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+
+	f, err := output.ParseFormat(*format)
+	if err != nil {
+		return err
+	}
+	columns, err := output.Columns(columnNames...)
+	if err != nil {
+		return err
+	}
+
+	cmd, ok := commands[fs.Arg(0)]
+	if !ok {
+		fs.Usage()
+		return fmt.Errorf("unknown command %q", fs.Arg(0))
+	}
+
+	apiKey, err := loadAPIKey(*configPath)
+	if err != nil {
+		return err
+	}
+
+	v, err := cmd.run(ctx, yelp.New(http.DefaultClient, apiKey), fs.Args()[1:])
+	if err != nil {
+		return err
+	}
+	return output.Write(w, f, v, columns)
+}
+
+// loadAPIKey returns the API key from the environment, falling back to the
+// config file at path.
+func loadAPIKey(path string) (string, error) {
+	if apiKey := os.Getenv("YELP_API_KEY"); apiKey != "" {
+		return apiKey, nil
+	}
+	if path == "" {
+		return "", errors.New("`YELP_API_KEY` must be specified")
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("`YELP_API_KEY` must be specified or set in %s", path)
+		}
+		return "", err
+	}
+	var c config
+	if err := json.Unmarshal(b, &c); err != nil {
+		return "", fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+	if c.APIKey == "" {
+		return "", fmt.Errorf("`api_key` is not set in %s", path)
+	}
+	return c.APIKey, nil
+}
+
+// defaultConfigPath returns the default location of the config file.
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "yelp", "config.json")
+}
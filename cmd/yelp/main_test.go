@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+func TestParseSearchFlags(t *testing.T) {
+	t.Run("Unset flags are nil", func(t *testing.T) {
+		options, err := parseSearchFlags([]string{"-location", "Kanto"})
+		if err != nil {
+			t.Fatalf("Expected no error (%v) for valid flags", err)
+		}
+		if yelp.StringValue(options.Location) != "Kanto" {
+			t.Fatalf("Location: Expected \"%s\" to equal \"Kanto\"", yelp.StringValue(options.Location))
+		}
+		if options.Term != nil || options.Coordinates != nil || options.Limit != nil || options.OpenNow != nil {
+			t.Fatalf("Expected unset flags to be nil: %+v", options)
+		}
+	})
+
+	t.Run("All flags are set", func(t *testing.T) {
+		options, err := parseSearchFlags([]string{
+			"-term", "ramen",
+			"-latitude", "37.77",
+			"-longitude", "-122.41",
+			"-radius", "1000",
+			"-categories", "ramen,noodles",
+			"-locale", "en_US",
+			"-limit", "10",
+			"-offset", "20",
+			"-sort-by", "rating",
+			"-price", "1,2",
+			"-open-at", "1574700000",
+			"-attributes", "hot_and_new",
+		})
+		if err != nil {
+			t.Fatalf("Expected no error (%v) for valid flags", err)
+		}
+		if err := options.Validate(); err != nil {
+			t.Fatalf("Expected valid options: %v", err)
+		}
+		vals := options.URLValues()
+		expected := map[string]string{
+			"term":       "ramen",
+			"latitude":   "37.77",
+			"longitude":  "-122.41",
+			"radius":     "1000",
+			"categories": "ramen,noodles",
+			"locale":     "en_US",
+			"limit":      "10",
+			"offset":     "20",
+			"sort_by":    "rating",
+			"price":      "1,2",
+			"open_at":    "1574700000",
+			"attributes": "hot_and_new",
+		}
+		for k, v := range expected {
+			if vals.Get(k) != v {
+				t.Fatalf("%s: Expected \"%s\" to equal \"%s\"", k, vals.Get(k), v)
+			}
+		}
+	})
+
+	t.Run("Invalid price", func(t *testing.T) {
+		if _, err := parseSearchFlags([]string{"-location", "Kanto", "-price", "$$"}); err == nil {
+			t.Fatal("Expected an error for an invalid price")
+		}
+	})
+}
+
+func TestParseBusinessFlags(t *testing.T) {
+	options, err := parseBusinessFlags([]string{"-locale", "fr_FR", "some-alias"})
+	if err != nil {
+		t.Fatalf("Expected no error (%v) for valid flags", err)
+	}
+	if options.ID != "some-alias" || yelp.StringValue(options.Locale) != "fr_FR" {
+		t.Fatalf("Unexpected options: %+v", options)
+	}
+}
+
+func TestParseReviewsFlags(t *testing.T) {
+	options, err := parseReviewsFlags([]string{"-limit", "3", "some-alias"})
+	if err != nil {
+		t.Fatalf("Expected no error (%v) for valid flags", err)
+	}
+	if options.ID != "some-alias" || yelp.Int64Value(options.Limit) != 3 || options.Offset != nil || options.Locale != nil {
+		t.Fatalf("Unexpected options: %+v", options)
+	}
+}
+
+func TestParseMatchFlags(t *testing.T) {
+	options, err := parseMatchFlags([]string{
+		"-name", "Pokemon Center",
+		"-address1", "1 Route 1",
+		"-city", "Viridian City",
+		"-state", "KT",
+		"-country", "JP",
+		"-latitude", "35.5",
+		"-longitude", "139.5",
+		"-match-threshold", "strict",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error (%v) for valid flags", err)
+	}
+	if err := options.Validate(); err != nil {
+		t.Fatalf("Expected valid options: %v", err)
+	}
+	if options.Coordinates == nil || options.Coordinates.Latitude != 35.5 || *options.MatchThreshold != yelp.MatchThresholdStrict {
+		t.Fatalf("Unexpected options: %+v", options)
+	}
+	if options.Address2 != nil || options.Phone != nil || options.Limit != nil {
+		t.Fatalf("Expected unset flags to be nil: %+v", options)
+	}
+}
+
+func TestParsePhoneFlags(t *testing.T) {
+	options, err := parsePhoneFlags([]string{"+14155550100"})
+	if err != nil {
+		t.Fatalf("Expected no error (%v) for valid flags", err)
+	}
+	if options.Phone != "+14155550100" || options.Locale != nil {
+		t.Fatalf("Unexpected options: %+v", options)
+	}
+}
+
+func TestParseAutocompleteFlags(t *testing.T) {
+	options, err := parseAutocompleteFlags([]string{"-latitude", "37.77", "-longitude", "-122.41", "pok"})
+	if err != nil {
+		t.Fatalf("Expected no error (%v) for valid flags", err)
+	}
+	if options.Text != "pok" || options.Coordinates == nil || options.Coordinates.Longitude != -122.41 {
+		t.Fatalf("Unexpected options: %+v", options)
+	}
+}
+
+func TestParseCategoriesFlags(t *testing.T) {
+	all, one, err := parseCategoriesFlags([]string{"-locale", "fr_FR"})
+	if err != nil {
+		t.Fatalf("Expected no error (%v) for valid flags", err)
+	}
+	if one != nil || all == nil || yelp.StringValue(all.Locale) != "fr_FR" {
+		t.Fatalf("Expected all categories to be requested: %+v %+v", all, one)
+	}
+
+	all, one, err = parseCategoriesFlags([]string{"hotdogs"})
+	if err != nil {
+		t.Fatalf("Expected no error (%v) for valid flags", err)
+	}
+	if all != nil || one == nil || one.Alias != "hotdogs" {
+		t.Fatalf("Expected a single category to be requested: %+v %+v", all, one)
+	}
+}
+
+// basicClient is a yelp.Client which only makes the search and business requests.
+type basicClient struct {
+	yelp.Client
+}
+
+func TestExtendedClient(t *testing.T) {
+	if _, err := extendedClient(yelp.New(nil, "API_KEY"), "reviews"); err != nil {
+		t.Fatalf("Expected the client returned by yelp.New to be extended: %v", err)
+	}
+	if _, err := runReviews(context.Background(), basicClient{}, []string{"some-alias"}); err == nil {
+		t.Fatal("Expected an error for clients which cannot make reviews requests")
+	}
+}
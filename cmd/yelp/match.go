@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// runMatch makes a Business Match request.
+func runMatch(ctx context.Context, client yelp.Client, args []string) (interface{}, error) {
+	options, err := parseMatchFlags(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	ec, err := extendedClient(client, "match")
+	if err != nil {
+		return nil, err
+	}
+	return ec.BusinessMatch(ctx, options)
+}
+
+// parseMatchFlags parses the flags of the match command into
+// BusinessMatchOptions.
+func parseMatchFlags(args []string) (*yelp.BusinessMatchOptions, error) {
+	fs := flag.NewFlagSet("match", flag.ContinueOnError)
+	name := fs.String("name", "", "name of the business")
+	address1 := fs.String("address1", "", "first line of the business address")
+	address2 := fs.String("address2", "", "second line of the business address")
+	address3 := fs.String("address3", "", "third line of the business address")
+	city := fs.String("city", "", "city of the business")
+	state := fs.String("state", "", "state code of the business, ie. CA")
+	country := fs.String("country", "", "ISO 3166-1 country code of the business, ie. US")
+	zipCode := fs.String("zip-code", "", "zip code of the business")
+	latitude := fs.Float64("latitude", 0, "latitude of the business")
+	longitude := fs.Float64("longitude", 0, "longitude of the business")
+	phone := fs.String("phone", "", "phone number of the business, ie. +14159083801")
+	yelpBusinessID := fs.String("yelp-business-id", "", "Yelp ID of the business, to check that it matches")
+	limit := fs.Int64("limit", 0, "number of businesses to return")
+	matchThreshold := fs.String("match-threshold", "", "none, default or strict")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	set := setFlags(fs)
+	options := &yelp.BusinessMatchOptions{
+		Name:     *name,
+		Address1: *address1,
+		City:     *city,
+		State:    *state,
+		Country:  *country,
+	}
+	if set["address2"] {
+		options.Address2 = address2
+	}
+	if set["address3"] {
+		options.Address3 = address3
+	}
+	if set["zip-code"] {
+		options.ZipCode = zipCode
+	}
+	if set["latitude"] || set["longitude"] {
+		options.Coordinates = &yelp.Coordinates{
+			Latitude:  *latitude,
+			Longitude: *longitude,
+		}
+	}
+	if set["phone"] {
+		options.Phone = phone
+	}
+	if set["yelp-business-id"] {
+		options.YelpBusinessID = yelpBusinessID
+	}
+	if set["limit"] {
+		options.Limit = limit
+	}
+	if set["match-threshold"] {
+		options.MatchThreshold = yelp.MatchThresholdPointer(yelp.MatchThreshold(*matchThreshold))
+	}
+	return options, nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// runPhone makes a Phone Search request.
+func runPhone(ctx context.Context, client yelp.Client, args []string) (interface{}, error) {
+	options, err := parsePhoneFlags(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	ec, err := extendedClient(client, "phone")
+	if err != nil {
+		return nil, err
+	}
+	return ec.PhoneSearch(ctx, options)
+}
+
+// parsePhoneFlags parses the flags of the phone command into
+// PhoneSearchOptions.
+func parsePhoneFlags(args []string) (*yelp.PhoneSearchOptions, error) {
+	fs := flag.NewFlagSet("phone", flag.ContinueOnError)
+	phone := fs.String("phone", "", "phone number to search for, ie. +14159083801")
+	locale := fs.String("locale", "", "locale of the results, ie. en_US")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *phone == "" && fs.NArg() > 0 {
+		*phone = fs.Arg(0)
+	}
+
+	set := setFlags(fs)
+	options := &yelp.PhoneSearchOptions{Phone: *phone}
+	if set["locale"] {
+		options.Locale = locale
+	}
+	return options, nil
+}
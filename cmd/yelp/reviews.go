@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// runReviews makes a Reviews request.
+func runReviews(ctx context.Context, client yelp.Client, args []string) (interface{}, error) {
+	options, err := parseReviewsFlags(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	ec, err := extendedClient(client, "reviews")
+	if err != nil {
+		return nil, err
+	}
+	return ec.Reviews(ctx, options)
+}
+
+// parseReviewsFlags parses the flags of the reviews command into
+// ReviewsOptions.
+func parseReviewsFlags(args []string) (*yelp.ReviewsOptions, error) {
+	fs := flag.NewFlagSet("reviews", flag.ContinueOnError)
+	id := fs.String("id", "", "business ID or alias")
+	locale := fs.String("locale", "", "locale of the returned reviews, ie. en_US")
+	limit := fs.Int64("limit", 0, "number of reviews to return")
+	offset := fs.Int64("offset", 0, "offset of the reviews to return")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *id == "" && fs.NArg() > 0 {
+		*id = fs.Arg(0)
+	}
+
+	set := setFlags(fs)
+	options := &yelp.ReviewsOptions{ID: *id}
+	if set["locale"] {
+		options.Locale = locale
+	}
+	if set["limit"] {
+		options.Limit = limit
+	}
+	if set["offset"] {
+		options.Offset = offset
+	}
+	return options, nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// runSearch makes a Business Search request.
+func runSearch(ctx context.Context, client yelp.Client, args []string) (interface{}, error) {
+	options, err := parseSearchFlags(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	return client.BusinessSearch(ctx, options)
+}
+
+// parseSearchFlags parses the flags of the search command into
+// BusinessSearchOptions.
+func parseSearchFlags(args []string) (*yelp.BusinessSearchOptions, error) {
+	var categories, price, attributes stringsFlag
+	fs := flag.NewFlagSet("search", flag.ContinueOnError)
+	term := fs.String("term", "", "search term, ie. \"food\" or \"restaurants\"")
+	location := fs.String("location", "", "address or name of the area to search")
+	latitude := fs.Float64("latitude", 0, "latitude of the area to search")
+	longitude := fs.Float64("longitude", 0, "longitude of the area to search")
+	radius := fs.Int64("radius", 0, "search radius in meters")
+	fs.Var(&categories, "categories", "comma separated category aliases, ie. \"bars,french\"")
+	locale := fs.String("locale", "", "locale of the results, ie. en_US")
+	limit := fs.Int64("limit", 0, "number of results to return")
+	offset := fs.Int64("offset", 0, "offset of the results to return")
+	sortBy := fs.String("sort-by", "", "best_match, rating, review_count or distance")
+	fs.Var(&price, "price", "comma separated price levels, ie. \"1,2\"")
+	openNow := fs.Bool("open-now", false, "only return businesses open now")
+	openAt := fs.Int64("open-at", 0, "only return businesses open at this unix time")
+	fs.Var(&attributes, "attributes", "comma separated attributes, ie. \"hot_and_new,deals\"")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	set := setFlags(fs)
+	options := &yelp.BusinessSearchOptions{
+		Categories: categories,
+	}
+	if set["term"] {
+		options.Term = term
+	}
+	if set["location"] {
+		options.Location = location
+	}
+	if set["latitude"] || set["longitude"] {
+		options.Coordinates = &yelp.Coordinates{
+			Latitude:  *latitude,
+			Longitude: *longitude,
+		}
+	}
+	if set["radius"] {
+		options.Radius = radius
+	}
+	if set["locale"] {
+		options.Locale = locale
+	}
+	if set["limit"] {
+		options.Limit = limit
+	}
+	if set["offset"] {
+		options.Offset = offset
+	}
+	if set["sort-by"] {
+		options.SortBy = yelp.SortByPointer(yelp.SortBy(*sortBy))
+	}
+	for _, p := range price {
+		level, err := strconv.ParseInt(p, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price level %q", p)
+		}
+		options.Price = append(options.Price, yelp.PriceLevel(level))
+	}
+	if set["open-now"] {
+		options.OpenNow = openNow
+	}
+	if set["open-at"] {
+		options.OpenAt = openAt
+	}
+	for _, a := range attributes {
+		options.Attributes = append(options.Attributes, yelp.Attribute(a))
+	}
+	return options, nil
+}
@@ -0,0 +1,77 @@
+package crawl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/alex-chou/go-yelp/internal/atomicfile"
+)
+
+// checkpointVersion is the version of the checkpoint file format.
+const checkpointVersion = 1
+
+// Checkpoint is the progress of a crawl, which is persisted so that the crawl
+// can be resumed.
+type Checkpoint struct {
+	Version int `json:"version"`
+
+	// Queries contains the progress of each query, keyed by query key.
+	Queries map[string]*QueryProgress `json:"queries"`
+
+	// Seen contains the IDs of the businesses written to the output.
+	Seen map[string]bool `json:"seen"`
+
+	// Requests is the number of search requests made, ie. the quota spent.
+	Requests int64 `json:"requests"`
+
+	// OutputOffset is the size of the output when the checkpoint was saved.
+	// Output written after it is discarded on resume.
+	OutputOffset int64 `json:"output_offset"`
+}
+
+// QueryProgress is the progress of paginating through a single query.
+type QueryProgress struct {
+	// Offset is the offset of the next page to request.
+	Offset int64 `json:"offset"`
+	Done   bool  `json:"done"`
+}
+
+// newCheckpoint returns an empty Checkpoint.
+func newCheckpoint() *Checkpoint {
+	return &Checkpoint{
+		Version: checkpointVersion,
+		Queries: map[string]*QueryProgress{},
+		Seen:    map[string]bool{},
+	}
+}
+
+// LoadCheckpoint reads the checkpoint at path. An empty checkpoint is returned
+// if the file does not exist.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return newCheckpoint(), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	cp := newCheckpoint()
+	if err := json.Unmarshal(b, cp); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint %s: %v", path, err)
+	}
+	if cp.Version != checkpointVersion {
+		return nil, fmt.Errorf("unsupported checkpoint version %d in %s", cp.Version, path)
+	}
+	return cp, nil
+}
+
+// Save atomically writes the checkpoint to path.
+func (cp *Checkpoint) Save(path string) error {
+	b, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(path, b, 0644)
+}
@@ -0,0 +1,218 @@
+// Package crawl paginates through Business Search results for a set of queries,
+// writing every business found to a JSONL file. Progress is checkpointed to disk
+// so that an interrupted crawl can be resumed with identical output.
+package crawl
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// Limits of the Business Search API pagination.
+const (
+	maxPageSize = 50
+	maxDepth    = 1000
+)
+
+// defaultCheckpointInterval is how often progress is checkpointed when no
+// interval is set.
+const defaultCheckpointInterval = 30 * time.Second
+
+// ErrQuotaExhausted is returned by Run when the job's request budget is spent
+// before the crawl is complete. The crawl can be resumed with a new budget.
+var ErrQuotaExhausted = errors.New("crawl request quota exhausted")
+
+// Query is a search to paginate through.
+type Query struct {
+	// Key identifies the query in the checkpoint. If unset, the encoded URL
+	// values of Options are used.
+	Key     string
+	Options yelp.BusinessSearchOptions
+}
+
+// key returns the key identifying q in a checkpoint.
+func (q Query) key() string {
+	if q.Key != "" {
+		return q.Key
+	}
+	options := q.Options
+	options.Limit, options.Offset = nil, nil
+	return options.URLValues().Encode()
+}
+
+// Job crawls a set of queries.
+type Job struct {
+	Client  yelp.Client
+	Queries []Query
+
+	// CheckpointPath is where progress is persisted and resumed from.
+	CheckpointPath string
+	// OutputPath is the JSONL file that businesses are appended to. Businesses
+	// found by more than one query are only written once.
+	OutputPath string
+
+	// CheckpointInterval is how often progress is checkpointed. Progress is
+	// also checkpointed when each query completes and when Run returns.
+	CheckpointInterval time.Duration
+	// PageSize is the number of businesses requested per page, up to 50.
+	PageSize int64
+	// MaxRequests is the number of requests Run may make in total, including
+	// requests made before resuming. Zero means unlimited.
+	MaxRequests int64
+}
+
+// checkpointInterval returns how often progress is checkpointed.
+func (j *Job) checkpointInterval() time.Duration {
+	if j.CheckpointInterval <= 0 {
+		return defaultCheckpointInterval
+	}
+	return j.CheckpointInterval
+}
+
+// Run crawls every query, resuming from the checkpoint if one exists.
+func (j *Job) Run(ctx context.Context) (err error) {
+	cp, err := LoadCheckpoint(j.CheckpointPath)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(j.OutputPath, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	// discard output written after the checkpoint, which will be crawled again
+	if err := f.Truncate(cp.OutputOffset); err != nil {
+		return err
+	}
+	if _, err := f.Seek(cp.OutputOffset, io.SeekStart); err != nil {
+		return err
+	}
+
+	r := &run{
+		job:   j,
+		cp:    cp,
+		f:     f,
+		out:   bufio.NewWriter(f),
+		saved: time.Now(),
+	}
+	defer func() {
+		if serr := r.checkpoint(); err == nil {
+			err = serr
+		}
+	}()
+
+	for _, q := range j.Queries {
+		if err := r.crawl(ctx, q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// run is the state of a single call to Job.Run.
+type run struct {
+	job   *Job
+	cp    *Checkpoint
+	f     *os.File
+	out   *bufio.Writer
+	saved time.Time
+}
+
+// crawl requests every page of q that is not already in the checkpoint.
+func (r *run) crawl(ctx context.Context, q Query) error {
+	key := q.key()
+	progress, ok := r.cp.Queries[key]
+	if !ok {
+		progress = &QueryProgress{}
+		r.cp.Queries[key] = progress
+	}
+
+	pageSize := r.job.PageSize
+	if pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	for !progress.Done {
+		if r.job.MaxRequests > 0 && r.cp.Requests >= r.job.MaxRequests {
+			return ErrQuotaExhausted
+		}
+		limit := pageSize
+		if progress.Offset+limit > maxDepth {
+			limit = maxDepth - progress.Offset
+		}
+
+		options := q.Options
+		options.Offset = yelp.Int64Pointer(progress.Offset)
+		options.Limit = yelp.Int64Pointer(limit)
+		// invalid options and requests stopped by ctx, ie. while waiting on a
+		// rate limiter, are not sent, so they do not spend quota
+		if err := options.Validate(); err != nil {
+			return err
+		}
+		results, err := r.job.Client.BusinessSearch(ctx, &options)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+				r.cp.Requests++
+			}
+			return err
+		}
+		r.cp.Requests++
+		if err := r.write(results.Businesses); err != nil {
+			return err
+		}
+
+		progress.Offset += int64(len(results.Businesses))
+		if len(results.Businesses) == 0 || progress.Offset >= results.Total || progress.Offset >= maxDepth {
+			progress.Done = true
+		}
+		if progress.Done || time.Since(r.saved) >= r.job.checkpointInterval() {
+			if err := r.checkpoint(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// write appends the businesses that have not been seen to the output.
+func (r *run) write(businesses []yelp.Business) error {
+	enc := json.NewEncoder(r.out)
+	for _, b := range businesses {
+		if r.cp.Seen[b.ID] {
+			continue
+		}
+		if err := enc.Encode(b); err != nil {
+			return err
+		}
+		r.cp.Seen[b.ID] = true
+	}
+	return nil
+}
+
+// checkpoint flushes the output and saves the checkpoint.
+func (r *run) checkpoint() error {
+	if err := r.out.Flush(); err != nil {
+		return err
+	}
+	if err := r.f.Sync(); err != nil {
+		return err
+	}
+	offset, err := r.f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	r.cp.OutputOffset = offset
+	r.saved = time.Now()
+	return r.cp.Save(r.job.CheckpointPath)
+}
@@ -0,0 +1,162 @@
+package crawl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// fakeClient serves pages of businesses for each search location.
+// Requests are not sent once ctx is done, and fail with err if it is set.
+type fakeClient struct {
+	businesses map[string][]yelp.Business
+	requests   int
+	err        error
+}
+
+func (f *fakeClient) BusinessSearch(ctx context.Context, bso *yelp.BusinessSearchOptions) (*yelp.BusinessSearchResults, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	f.requests++
+	if f.err != nil {
+		return nil, f.err
+	}
+	all := f.businesses[yelp.StringValue(bso.Location)]
+	start, end := yelp.Int64Value(bso.Offset), yelp.Int64Value(bso.Offset)+yelp.Int64Value(bso.Limit)
+	if start > int64(len(all)) {
+		start = int64(len(all))
+	}
+	if end > int64(len(all)) {
+		end = int64(len(all))
+	}
+	return &yelp.BusinessSearchResults{
+		Total:      int64(len(all)),
+		Businesses: all[start:end],
+	}, nil
+}
+
+func (f *fakeClient) GetBusiness(ctx context.Context, gbo *yelp.GetBusinessOptions) (*yelp.Business, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func newFakeClient() *fakeClient {
+	f := &fakeClient{businesses: map[string][]yelp.Business{}}
+	for i := 0; i < 23; i++ {
+		f.businesses["Kanto"] = append(f.businesses["Kanto"], yelp.Business{ID: fmt.Sprintf("kanto-%d", i)})
+	}
+	for i := 0; i < 11; i++ {
+		f.businesses["Johto"] = append(f.businesses["Johto"], yelp.Business{ID: fmt.Sprintf("johto-%d", i)})
+	}
+	// businesses found by both queries are only written once
+	f.businesses["Johto"] = append(f.businesses["Johto"], yelp.Business{ID: "kanto-3"})
+	return f
+}
+
+func newJob(dir string, client yelp.Client) *Job {
+	return &Job{
+		Client: client,
+		Queries: []Query{
+			{Options: yelp.BusinessSearchOptions{Location: yelp.StringPointer("Kanto")}},
+			{Key: "johto", Options: yelp.BusinessSearchOptions{Location: yelp.StringPointer("Johto")}},
+		},
+		CheckpointPath: filepath.Join(dir, "checkpoint.json"),
+		OutputPath:     filepath.Join(dir, "output.jsonl"),
+		PageSize:       5,
+	}
+}
+
+func TestJob(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "crawl")
+	assert(t, err == nil, "Expected no error (%v) creating a temp dir", err)
+	defer os.RemoveAll(dir)
+
+	completeDir, resumedDir := filepath.Join(dir, "complete"), filepath.Join(dir, "resumed")
+	os.Mkdir(completeDir, 0755)
+	os.Mkdir(resumedDir, 0755)
+
+	t.Run("Complete crawl", func(t *testing.T) {
+		client := newFakeClient()
+		job := newJob(completeDir, client)
+		assert(t, job.Run(ctx) == nil, "Expected the crawl to complete")
+
+		b, _ := ioutil.ReadFile(job.OutputPath)
+		lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+		assert(t, len(lines) == 34, "Expected 34 unique businesses, got %d", len(lines))
+		assert(t, client.requests == 8, "Expected 8 requests, got %d", client.requests)
+
+		cp, err := LoadCheckpoint(job.CheckpointPath)
+		assert(t, err == nil && cp.Requests == 8 && cp.Queries["johto"].Done, "Unexpected checkpoint (%v): %+v", err, cp)
+	})
+
+	t.Run("Resumed crawl", func(t *testing.T) {
+		job := newJob(resumedDir, newFakeClient())
+		job.MaxRequests = 3
+		assert(t, job.Run(ctx) == ErrQuotaExhausted, "Expected the crawl to stop when the quota is exhausted")
+
+		// output written after the checkpoint is discarded on resume
+		f, _ := os.OpenFile(job.OutputPath, os.O_APPEND|os.O_WRONLY, 0644)
+		f.WriteString("{\"id\":\"partial\"}\n")
+		f.Close()
+
+		client := newFakeClient()
+		job = newJob(resumedDir, client)
+		job.MaxRequests = 8
+		assert(t, job.Run(ctx) == nil, "Expected the resumed crawl to complete")
+		assert(t, client.requests == 5, "Expected only the remaining 5 requests, got %d", client.requests)
+
+		complete, _ := ioutil.ReadFile(filepath.Join(completeDir, "output.jsonl"))
+		resumed, _ := ioutil.ReadFile(job.OutputPath)
+		assert(t, string(complete) == string(resumed), "Expected resumed output to match:\n%s\nto:\n%s", resumed, complete)
+	})
+}
+
+func TestJobRequests(t *testing.T) {
+	dir, err := ioutil.TempDir("", "crawl")
+	assert(t, err == nil, "Expected no error (%v) creating a temp dir", err)
+	defer os.RemoveAll(dir)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i, tc := range []struct {
+		name     string
+		ctx      context.Context
+		radius   int64
+		err      error
+		requests int64
+	}{
+		{"Invalid options", context.Background(), 50000, nil, 0},
+		{"Canceled context", canceled, 0, nil, 0},
+		{"Failed request", context.Background(), 0, errors.New("500 Internal Server Error"), 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			jobDir := filepath.Join(dir, fmt.Sprint(i))
+			os.Mkdir(jobDir, 0755)
+			client := newFakeClient()
+			client.err = tc.err
+			job := newJob(jobDir, client)
+			if tc.radius != 0 {
+				job.Queries[0].Options.Radius = yelp.Int64Pointer(tc.radius)
+			}
+			assert(t, job.Run(tc.ctx) != nil, "Expected the crawl to fail")
+
+			cp, err := LoadCheckpoint(job.CheckpointPath)
+			assert(t, err == nil && cp.Requests == tc.requests && int64(client.requests) == tc.requests,
+				"Expected %d requests to be sent and counted, got %d sent and %d counted (%v)", tc.requests, client.requests, cp.Requests, err)
+		})
+	}
+}
+
+func assert(t *testing.T, condition bool, assertionFormat string, values ...interface{}) {
+	if !condition {
+		t.Fatalf(assertionFormat, values...)
+	}
+}
@@ -0,0 +1,295 @@
+// Package index contains in-memory spatial and full-text indexes over local
+// collections of businesses, for answering queries without the Yelp API.
+package index
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// defaultCellSize is the size in degrees of the grid cells of a SpatialIndex
+// when none is given, about 1km of latitude.
+const defaultCellSize = 0.01
+
+// earthRadius is the mean radius of the Earth in meters.
+const earthRadius = 6371008.8
+
+// Result is a business found by a query, with its distance in meters from the
+// query center.
+type Result struct {
+	Business yelp.Business
+	Distance float64
+}
+
+// SpatialIndex indexes businesses by their coordinates in a grid of fixed size
+// cells, for radius, bounding box and nearest neighbour queries. It is safe for
+// concurrent use.
+type SpatialIndex struct {
+	mu       sync.RWMutex
+	cellSize float64
+	cols     int
+	cells    map[cell][]*entry
+	entries  map[string]*entry
+}
+
+// cell is the position of a grid cell, by latitude row and longitude column.
+type cell struct {
+	row int
+	col int
+}
+
+// entry is an indexed business and its position in its cell.
+type entry struct {
+	business yelp.Business
+	cell     cell
+	pos      int
+}
+
+// NewSpatialIndex returns an empty SpatialIndex with grid cells of cellSize
+// degrees. Smaller cells make queries over small areas faster at the cost of
+// memory. If cellSize is not positive, cells of 0.01 degrees are used.
+func NewSpatialIndex(cellSize float64) *SpatialIndex {
+	if cellSize <= 0 {
+		cellSize = defaultCellSize
+	}
+	return &SpatialIndex{
+		cellSize: cellSize,
+		cols:     int(math.Ceil(360 / cellSize)),
+		cells:    map[cell][]*entry{},
+		entries:  map[string]*entry{},
+	}
+}
+
+// Len returns the number of indexed businesses.
+func (si *SpatialIndex) Len() int {
+	si.mu.RLock()
+	defer si.mu.RUnlock()
+	return len(si.entries)
+}
+
+// Get returns the indexed business with the given ID.
+func (si *SpatialIndex) Get(id string) (yelp.Business, bool) {
+	si.mu.RLock()
+	defer si.mu.RUnlock()
+	e, ok := si.entries[id]
+	if !ok {
+		return yelp.Business{}, false
+	}
+	return e.business, true
+}
+
+// Insert adds b to the index, replacing any business with the same ID.
+func (si *SpatialIndex) Insert(b yelp.Business) {
+	si.mu.Lock()
+	defer si.mu.Unlock()
+	if e, ok := si.entries[b.ID]; ok {
+		si.remove(e)
+	}
+
+	c := si.cellOf(b.Coodinates)
+	e := &entry{business: b, cell: c, pos: len(si.cells[c])}
+	si.cells[c] = append(si.cells[c], e)
+	si.entries[b.ID] = e
+}
+
+// Update replaces the indexed business with the ID of b. It is the same as
+// Insert.
+func (si *SpatialIndex) Update(b yelp.Business) {
+	si.Insert(b)
+}
+
+// Delete removes the business with the given ID, returning whether it was
+// indexed.
+func (si *SpatialIndex) Delete(id string) bool {
+	si.mu.Lock()
+	defer si.mu.Unlock()
+	e, ok := si.entries[id]
+	if ok {
+		si.remove(e)
+	}
+	return ok
+}
+
+// remove removes e from the index.
+func (si *SpatialIndex) remove(e *entry) {
+	entries := si.cells[e.cell]
+	last := len(entries) - 1
+	entries[e.pos] = entries[last]
+	entries[e.pos].pos = e.pos
+	entries[last] = nil
+	if last == 0 {
+		delete(si.cells, e.cell)
+	} else {
+		si.cells[e.cell] = entries[:last]
+	}
+	delete(si.entries, e.business.ID)
+}
+
+// Within returns the businesses within radius meters of center, nearest first.
+func (si *SpatialIndex) Within(center yelp.Coordinates, radius float64) []Result {
+	si.mu.RLock()
+	defer si.mu.RUnlock()
+
+	var found []candidate
+	si.visitBox(yelp.NewBoundingBox(center, radius), func(e *entry) {
+		if d := center.DistanceTo(e.business.Coodinates); d <= radius {
+			found = append(found, candidate{entry: e, distance: d})
+		}
+	})
+	return results(found, len(found))
+}
+
+// InBoundingBox returns the businesses inside box.
+func (si *SpatialIndex) InBoundingBox(box yelp.BoundingBox) []yelp.Business {
+	si.mu.RLock()
+	defer si.mu.RUnlock()
+
+	var businesses []yelp.Business
+	si.visitBox(box, func(e *entry) {
+		if box.Contains(e.business.Coodinates) {
+			businesses = append(businesses, e.business)
+		}
+	})
+	return businesses
+}
+
+// Nearest returns the k businesses nearest to center, nearest first.
+func (si *SpatialIndex) Nearest(center yelp.Coordinates, k int) []Result {
+	si.mu.RLock()
+	defer si.mu.RUnlock()
+	if k <= 0 || len(si.entries) == 0 {
+		return nil
+	}
+
+	// search rings of cells around the center until the k nearest found are
+	// closer than any business outside of the rings could be
+	origin := si.cellOf(center)
+	visited := map[cell]bool{}
+	var found []candidate
+	seen := 0
+	for r := 0; seen < len(si.entries); r++ {
+		// scanning every occupied cell is faster than scanning a larger ring
+		if 8*r > len(si.cells) {
+			found = found[:0]
+			for _, e := range si.entries {
+				found = append(found, candidate{entry: e, distance: center.DistanceTo(e.business.Coodinates)})
+			}
+			break
+		}
+		for _, c := range si.ring(origin, r) {
+			if visited[c] {
+				continue
+			}
+			visited[c] = true
+			for _, e := range si.cells[c] {
+				found = append(found, candidate{entry: e, distance: center.DistanceTo(e.business.Coodinates)})
+				seen++
+			}
+		}
+		if len(found) >= k {
+			sortCandidates(found)
+			found = found[:k]
+			if found[k-1].distance <= si.ringDistance(center, r) {
+				break
+			}
+		}
+	}
+	return results(found, k)
+}
+
+// ringDistance returns a lower bound in meters on the distance from center to
+// any point outside of the first r rings of cells around it.
+func (si *SpatialIndex) ringDistance(center yelp.Coordinates, r int) float64 {
+	// such a point is at least r cells of latitude or longitude away, and
+	// longitudes are closest together at the latitude furthest from the equator
+	delta := math.Min(math.Pi, float64(r)*si.cellSize*math.Pi/180)
+	maxLat := math.Min(90, math.Abs(center.Latitude)+float64(r+1)*si.cellSize)
+	return 2 * earthRadius * math.Asin(math.Cos(maxLat*math.Pi/180)*math.Sin(delta/2))
+}
+
+// ring returns the cells r cells away from origin.
+func (si *SpatialIndex) ring(origin cell, r int) []cell {
+	if r == 0 {
+		return []cell{origin}
+	}
+	var cells []cell
+	for dc := -r; dc <= r; dc++ {
+		cells = append(cells, si.wrap(origin.row-r, origin.col+dc), si.wrap(origin.row+r, origin.col+dc))
+	}
+	for dr := -r + 1; dr < r; dr++ {
+		cells = append(cells, si.wrap(origin.row+dr, origin.col-r), si.wrap(origin.row+dr, origin.col+r))
+	}
+	return cells
+}
+
+// visitBox calls visit with every entry in the cells overlapping box.
+func (si *SpatialIndex) visitBox(box yelp.BoundingBox, visit func(*entry)) {
+	sw, ne := si.cellOf(box.SouthWest), si.cellOf(box.NorthEast)
+	cols := ne.col - sw.col + 1
+	if cols <= 0 || box.NorthEast.Longitude-box.SouthWest.Longitude >= 360 {
+		// the box crosses the antimeridian or spans every longitude
+		cols += si.cols
+	}
+	if cols > si.cols {
+		cols = si.cols
+	}
+
+	// scanning every occupied cell is faster than scanning a larger box
+	if (ne.row-sw.row+1)*cols > len(si.cells) {
+		for _, entries := range si.cells {
+			for _, e := range entries {
+				visit(e)
+			}
+		}
+		return
+	}
+	for row := sw.row; row <= ne.row; row++ {
+		for i := 0; i < cols; i++ {
+			for _, e := range si.cells[si.wrap(row, sw.col+i)] {
+				visit(e)
+			}
+		}
+	}
+}
+
+// cellOf returns the cell containing c.
+func (si *SpatialIndex) cellOf(c yelp.Coordinates) cell {
+	return si.wrap(
+		int(math.Floor((c.Latitude+90)/si.cellSize)),
+		int(math.Floor((c.Longitude+180)/si.cellSize)),
+	)
+}
+
+// wrap returns the cell at row and col, wrapping col around the antimeridian.
+func (si *SpatialIndex) wrap(row, col int) cell {
+	return cell{row: row, col: ((col % si.cols) + si.cols) % si.cols}
+}
+
+// candidate is an entry found by a query and its distance from the query center.
+type candidate struct {
+	entry    *entry
+	distance float64
+}
+
+// sortCandidates sorts candidates nearest first.
+func sortCandidates(candidates []candidate) {
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+}
+
+// results returns the nearest k candidates as Results, nearest first.
+func results(candidates []candidate, k int) []Result {
+	sortCandidates(candidates)
+	if len(candidates) > k {
+		candidates = candidates[:k]
+	}
+	results := make([]Result, len(candidates))
+	for i, c := range candidates {
+		results[i] = Result{Business: c.entry.business, Distance: c.distance}
+	}
+	return results
+}
@@ -0,0 +1,189 @@
+package index
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// randomBusinesses returns n businesses spread around the San Francisco Bay Area,
+// with a few around the antimeridian.
+func randomBusinesses(r *rand.Rand, n int) []yelp.Business {
+	businesses := make([]yelp.Business, n)
+	for i := range businesses {
+		c := yelp.Coordinates{
+			Latitude:  37.3 + r.Float64(),
+			Longitude: -122.6 + r.Float64(),
+		}
+		if i%100 == 0 {
+			c = yelp.Coordinates{
+				Latitude:  -17 + r.Float64(),
+				Longitude: 179.5 + r.Float64() - 360*float64(r.Intn(2)),
+			}
+		}
+		businesses[i] = yelp.Business{ID: fmt.Sprintf("business-%d", i), Coodinates: c}
+	}
+	return businesses
+}
+
+// bruteForce returns every business sorted by distance from center.
+func bruteForce(businesses []yelp.Business, center yelp.Coordinates) []Result {
+	results := make([]Result, len(businesses))
+	for i, b := range businesses {
+		results[i] = Result{Business: b, Distance: center.DistanceTo(b.Coodinates)}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Distance < results[j].Distance
+	})
+	return results
+}
+
+func sameIDs(a, b []Result) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Business.ID != b[i].Business.ID {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSpatialIndex(t *testing.T) {
+	r := rand.New(rand.NewSource(151))
+	businesses := randomBusinesses(r, 5000)
+	si := NewSpatialIndex(0)
+	for _, b := range businesses {
+		si.Insert(b)
+	}
+	assert(t, si.Len() == len(businesses), "Expected %d businesses, got %d", len(businesses), si.Len())
+
+	centers := []yelp.Coordinates{
+		{Latitude: 37.7749, Longitude: -122.4194},
+		{Latitude: 37.3, Longitude: -122.6},
+		{Latitude: -16.5, Longitude: 179.99},
+		{Latitude: 0, Longitude: 0},
+	}
+
+	t.Run("Within", func(t *testing.T) {
+		for _, center := range centers {
+			var expected []Result
+			for _, res := range bruteForce(businesses, center) {
+				if res.Distance <= 5000 {
+					expected = append(expected, res)
+				}
+			}
+			results := si.Within(center, 5000)
+			assert(t, sameIDs(results, expected), "Expected %d results within 5km of %v, got %d", len(expected), center, len(results))
+		}
+	})
+
+	t.Run("Nearest", func(t *testing.T) {
+		for _, center := range centers {
+			for _, k := range []int{1, 10, 100} {
+				expected := bruteForce(businesses, center)[:k]
+				results := si.Nearest(center, k)
+				assert(t, sameIDs(results, expected), "Expected the %d nearest to %v to match brute force", k, center)
+			}
+		}
+		assert(t, len(si.Nearest(centers[0], len(businesses)+10)) == len(businesses), "Expected every business when k is larger than the index")
+	})
+
+	t.Run("InBoundingBox", func(t *testing.T) {
+		boxes := []yelp.BoundingBox{
+			{SouthWest: yelp.Coordinates{Latitude: 37.7, Longitude: -122.5}, NorthEast: yelp.Coordinates{Latitude: 37.8, Longitude: -122.4}},
+			{SouthWest: yelp.Coordinates{Latitude: -17, Longitude: 179.8}, NorthEast: yelp.Coordinates{Latitude: -16, Longitude: -179.8}},
+			{SouthWest: yelp.Coordinates{Latitude: -90, Longitude: -180}, NorthEast: yelp.Coordinates{Latitude: 90, Longitude: 180}},
+		}
+		for _, box := range boxes {
+			expected := 0
+			for _, b := range businesses {
+				if box.Contains(b.Coodinates) {
+					expected++
+				}
+			}
+			found := si.InBoundingBox(box)
+			assert(t, len(found) == expected, "Expected %d businesses in %v, got %d", expected, box, len(found))
+		}
+	})
+
+	t.Run("Update and Delete", func(t *testing.T) {
+		center := centers[0]
+		nearest := si.Nearest(center, 1)[0].Business
+
+		moved := nearest
+		moved.Coodinates = yelp.Coordinates{Latitude: 10, Longitude: 10}
+		si.Update(moved)
+		assert(t, si.Len() == len(businesses), "Expected updating to not change the size of the index")
+		assert(t, si.Nearest(center, 1)[0].Business.ID != nearest.ID, "Expected the moved business to no longer be nearest")
+		assert(t, si.Nearest(moved.Coodinates, 1)[0].Business.ID == nearest.ID, "Expected the moved business at its new coordinates")
+
+		assert(t, si.Delete(nearest.ID), "Expected the business to be deleted")
+		assert(t, !si.Delete(nearest.ID), "Expected deleting twice to return false")
+		_, ok := si.Get(nearest.ID)
+		assert(t, !ok && si.Len() == len(businesses)-1, "Expected the business to be removed")
+	})
+}
+
+var (
+	benchmarkIndex     *SpatialIndex
+	benchmarkIndexOnce sync.Once
+)
+
+// millionPointIndex returns a SpatialIndex of 1M businesses.
+func millionPointIndex() *SpatialIndex {
+	benchmarkIndexOnce.Do(func() {
+		benchmarkIndex = NewSpatialIndex(0)
+		for _, b := range randomBusinesses(rand.New(rand.NewSource(1)), 1000000) {
+			benchmarkIndex.Insert(b)
+		}
+	})
+	return benchmarkIndex
+}
+
+func BenchmarkSpatialIndexWithin1km(b *testing.B) {
+	si := millionPointIndex()
+	center := yelp.Coordinates{Latitude: 37.7749, Longitude: -122.4194}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		si.Within(center, 1000)
+	}
+}
+
+func BenchmarkSpatialIndexInBoundingBox(b *testing.B) {
+	si := millionPointIndex()
+	box := yelp.NewBoundingBox(yelp.Coordinates{Latitude: 37.7749, Longitude: -122.4194}, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		si.InBoundingBox(box)
+	}
+}
+
+func BenchmarkSpatialIndexNearest10(b *testing.B) {
+	si := millionPointIndex()
+	center := yelp.Coordinates{Latitude: 37.7749, Longitude: -122.4194}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		si.Nearest(center, 10)
+	}
+}
+
+func BenchmarkSpatialIndexInsert(b *testing.B) {
+	si := millionPointIndex()
+	businesses := randomBusinesses(rand.New(rand.NewSource(2)), b.N)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		si.Insert(businesses[i])
+	}
+}
+
+func assert(t *testing.T, condition bool, assertionFormat string, values ...interface{}) {
+	if !condition {
+		t.Fatalf(assertionFormat, values...)
+	}
+}
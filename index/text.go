@@ -0,0 +1,262 @@
+package index
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// BM25 parameters.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// nameWeight is how many times more a term in a business's name counts than a
+// term in its categories or address.
+const nameWeight = 2
+
+// TextIndex is an inverted index over the names, categories and addresses of
+// businesses, scored with BM25. It is safe for concurrent use.
+type TextIndex struct {
+	mu     sync.RWMutex
+	locale string
+
+	docs        map[string]*document
+	postings    map[string]map[string]int
+	terms       []string
+	totalLength int
+}
+
+// document is an indexed business and the frequency of its terms.
+type document struct {
+	business yelp.Business
+	terms    map[string]int
+	length   int
+}
+
+// TextQuery is a search of a TextIndex.
+type TextQuery struct {
+	// Text must match every term of the business, except that the last term
+	// only needs to match the start of a term when Prefix is set, for
+	// autocomplete.
+	Text   string
+	Prefix bool
+
+	// Center and Radius, when set, only match businesses within Radius meters
+	// of Center.
+	Center *yelp.Coordinates
+	Radius float64
+
+	// Price, when set, only matches businesses with any of the price levels.
+	Price yelp.PriceLevels
+	// MinRating only matches businesses rated at least MinRating.
+	MinRating float64
+
+	// Filter, when set, only matches businesses for which it returns true.
+	Filter func(yelp.Business) bool
+
+	// Limit is the number of results to return. Zero means unlimited.
+	Limit int
+}
+
+// TextResult is a business matching a TextQuery.
+type TextResult struct {
+	Business yelp.Business
+	Score    float64
+	// Distance is the distance in meters from the query Center, if set.
+	Distance float64
+}
+
+// NewTextIndex returns an empty TextIndex which tokenizes text for the given
+// Yelp locale, ie. "en_US".
+func NewTextIndex(locale string) *TextIndex {
+	return &TextIndex{
+		locale:   locale,
+		docs:     map[string]*document{},
+		postings: map[string]map[string]int{},
+	}
+}
+
+// Len returns the number of indexed businesses.
+func (ti *TextIndex) Len() int {
+	ti.mu.RLock()
+	defer ti.mu.RUnlock()
+	return len(ti.docs)
+}
+
+// Insert adds b to the index, replacing any business with the same ID.
+func (ti *TextIndex) Insert(b yelp.Business) {
+	doc := &document{business: b, terms: map[string]int{}}
+	for _, t := range Tokenize(ti.locale, b.Name) {
+		doc.terms[t] += nameWeight
+		doc.length += nameWeight
+	}
+	var fields []string
+	for _, c := range b.Categories {
+		fields = append(fields, c.Title, strings.Replace(c.Alias, "_", " ", -1))
+	}
+	fields = append(fields, b.Location.DisplayAddress...)
+	fields = append(fields, b.Location.City, b.Location.ZipCode)
+	for _, t := range Tokenize(ti.locale, strings.Join(fields, " ")) {
+		doc.terms[t]++
+		doc.length++
+	}
+
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+	if old, ok := ti.docs[b.ID]; ok {
+		ti.remove(old)
+	}
+	ti.docs[b.ID] = doc
+	ti.totalLength += doc.length
+	for t, freq := range doc.terms {
+		posting, ok := ti.postings[t]
+		if !ok {
+			posting = map[string]int{}
+			ti.postings[t] = posting
+			i := sort.SearchStrings(ti.terms, t)
+			ti.terms = append(ti.terms, "")
+			copy(ti.terms[i+1:], ti.terms[i:])
+			ti.terms[i] = t
+		}
+		posting[b.ID] = freq
+	}
+}
+
+// Delete removes the business with the given ID, returning whether it was
+// indexed.
+func (ti *TextIndex) Delete(id string) bool {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+	doc, ok := ti.docs[id]
+	if ok {
+		ti.remove(doc)
+	}
+	return ok
+}
+
+// remove removes doc from the index.
+func (ti *TextIndex) remove(doc *document) {
+	id := doc.business.ID
+	for t := range doc.terms {
+		posting := ti.postings[t]
+		delete(posting, id)
+		if len(posting) == 0 {
+			delete(ti.postings, t)
+			i := sort.SearchStrings(ti.terms, t)
+			ti.terms = append(ti.terms[:i], ti.terms[i+1:]...)
+		}
+	}
+	ti.totalLength -= doc.length
+	delete(ti.docs, id)
+}
+
+// Complete returns up to limit indexed terms starting with prefix, most
+// frequent first, for autocomplete.
+func (ti *TextIndex) Complete(prefix string, limit int) []string {
+	tokens := Tokenize(ti.locale, prefix)
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	ti.mu.RLock()
+	defer ti.mu.RUnlock()
+	terms := ti.withPrefix(tokens[len(tokens)-1])
+	sort.SliceStable(terms, func(i, j int) bool {
+		return len(ti.postings[terms[i]]) > len(ti.postings[terms[j]])
+	})
+	if limit > 0 && len(terms) > limit {
+		terms = terms[:limit]
+	}
+	return terms
+}
+
+// Search returns the businesses matching q, highest scoring first.
+func (ti *TextIndex) Search(q TextQuery) []TextResult {
+	tokens := Tokenize(ti.locale, q.Text)
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	ti.mu.RLock()
+	defer ti.mu.RUnlock()
+
+	// each query term matches one or more indexed terms, and a business must
+	// match every query term
+	matches := make([][]string, len(tokens))
+	for i, t := range tokens {
+		if q.Prefix && i == len(tokens)-1 {
+			matches[i] = ti.withPrefix(t)
+		} else if _, ok := ti.postings[t]; ok {
+			matches[i] = []string{t}
+		}
+		if len(matches[i]) == 0 {
+			return nil
+		}
+	}
+
+	avgLength := float64(ti.totalLength) / float64(len(ti.docs))
+	scores := map[string]float64{}
+	for i, terms := range matches {
+		termScores := map[string]float64{}
+		for _, t := range terms {
+			posting := ti.postings[t]
+			idf := math.Log(1 + (float64(len(ti.docs))-float64(len(posting))+0.5)/(float64(len(posting))+0.5))
+			for id, freq := range posting {
+				if i > 0 {
+					if _, ok := scores[id]; !ok {
+						continue
+					}
+				}
+				tf := float64(freq)
+				norm := tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*float64(ti.docs[id].length)/avgLength))
+				// a prefix matching several terms of a business counts once
+				termScores[id] = math.Max(termScores[id], idf*norm)
+			}
+		}
+		next := map[string]float64{}
+		for id, s := range termScores {
+			next[id] = scores[id] + s
+		}
+		scores = next
+	}
+
+	var results []TextResult
+	for id, score := range scores {
+		b := ti.docs[id].business
+		result := TextResult{Business: b, Score: score}
+		if q.Center != nil {
+			result.Distance = q.Center.DistanceTo(b.Coodinates)
+			if result.Distance > q.Radius {
+				continue
+			}
+		}
+		if !q.Price.Matches(b.Price) || b.Rating < q.MinRating || (q.Filter != nil && !q.Filter(b)) {
+			continue
+		}
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Business.ID < results[j].Business.ID
+	})
+	if q.Limit > 0 && len(results) > q.Limit {
+		results = results[:q.Limit]
+	}
+	return results
+}
+
+// withPrefix returns the indexed terms starting with prefix.
+func (ti *TextIndex) withPrefix(prefix string) []string {
+	var terms []string
+	for i := sort.SearchStrings(ti.terms, prefix); i < len(ti.terms) && strings.HasPrefix(ti.terms[i], prefix); i++ {
+		terms = append(terms, ti.terms[i])
+	}
+	return terms
+}
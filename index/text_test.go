@@ -0,0 +1,120 @@
+package index
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+func TestTokenize(t *testing.T) {
+	cases := []struct {
+		locale   string
+		text     string
+		expected []string
+	}{
+		{"en_US", "Joe's Pizza & Pasta, 94107", []string{"joes", "pizza", "pasta", "94107"}},
+		{"fr_FR", "Café Crème", []string{"cafe", "creme"}},
+		{"de_DE", "Straße", []string{"strasse"}},
+		{"tr_TR", "İSTANBUL", []string{"istanbul"}},
+		{"ja_JP", "すし 銀座店", []string{"すし", "銀座", "座店"}},
+		{"zh_TW", "麵 noodles", []string{"麵", "noodles"}},
+	}
+	for _, c := range cases {
+		tokens := Tokenize(c.locale, c.text)
+		assert(t, reflect.DeepEqual(tokens, c.expected), "Tokenize(%s, %q): Expected %q to equal %q", c.locale, c.text, tokens, c.expected)
+	}
+}
+
+func TestTextIndex(t *testing.T) {
+	businesses := []yelp.Business{
+		{
+			ID:         "pizza-1",
+			Name:       "Tony's Pizza Napoletana",
+			Price:      "$$",
+			Rating:     4.5,
+			Categories: []yelp.Category{{Alias: "pizza", Title: "Pizza"}, {Alias: "italian", Title: "Italian"}},
+			Location:   yelp.Location{DisplayAddress: []string{"1570 Stockton St", "San Francisco, CA 94133"}},
+			Coodinates: yelp.Coordinates{Latitude: 37.8003, Longitude: -122.4091},
+		},
+		{
+			ID:         "pizza-2",
+			Name:       "Golden Boy Pizza",
+			Price:      "$",
+			Rating:     4.0,
+			Categories: []yelp.Category{{Alias: "pizza", Title: "Pizza"}},
+			Location:   yelp.Location{DisplayAddress: []string{"542 Green St", "San Francisco, CA 94133"}},
+			Coodinates: yelp.Coordinates{Latitude: 37.7998, Longitude: -122.4075},
+		},
+		{
+			ID:         "pasta-3",
+			Name:       "Pasta Pomodoro",
+			Price:      "$$",
+			Rating:     3.5,
+			Categories: []yelp.Category{{Alias: "italian", Title: "Italian"}},
+			Location:   yelp.Location{DisplayAddress: []string{"1 Pizza Way", "Oakland, CA 94612"}},
+			Coodinates: yelp.Coordinates{Latitude: 37.8044, Longitude: -122.2712},
+		},
+	}
+	ti := NewTextIndex("en_US")
+	for _, b := range businesses {
+		ti.Insert(b)
+	}
+	ids := func(results []TextResult) []string {
+		var ids []string
+		for _, r := range results {
+			ids = append(ids, r.Business.ID)
+		}
+		return ids
+	}
+
+	t.Run("Search", func(t *testing.T) {
+		results := ti.Search(TextQuery{Text: "pizza"})
+		assert(t, reflect.DeepEqual(ids(results), []string{"pizza-2", "pizza-1", "pasta-3"}), "Expected name matches to score highest: %v", ids(results))
+
+		results = ti.Search(TextQuery{Text: "italian pizza"})
+		assert(t, reflect.DeepEqual(ids(results), []string{"pizza-1", "pasta-3"}), "Expected every term to match: %v", ids(results))
+
+		assert(t, len(ti.Search(TextQuery{Text: "sushi"})) == 0, "Expected no results for unknown terms")
+	})
+
+	t.Run("Prefix", func(t *testing.T) {
+		results := ti.Search(TextQuery{Text: "golden pi", Prefix: true})
+		assert(t, reflect.DeepEqual(ids(results), []string{"pizza-2"}), "Expected prefix matches: %v", ids(results))
+		assert(t, len(ti.Search(TextQuery{Text: "golden pi"})) == 0, "Expected no matches without Prefix")
+
+		completions := ti.Complete("Na", 5)
+		assert(t, reflect.DeepEqual(completions, []string{"napoletana"}), "Unexpected completions: %v", completions)
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		center := businesses[0].Coodinates
+		results := ti.Search(TextQuery{Text: "pizza", Center: &center, Radius: 1000})
+		assert(t, reflect.DeepEqual(ids(results), []string{"pizza-2", "pizza-1"}), "Expected distant businesses to be filtered: %v", ids(results))
+		assert(t, results[1].Distance == 0, "Expected distances to be set: %+v", results[1])
+
+		results = ti.Search(TextQuery{Text: "pizza", Price: yelp.PriceLevels{yelp.PriceModerate}, MinRating: 4})
+		assert(t, reflect.DeepEqual(ids(results), []string{"pizza-1"}), "Expected price and rating filters: %v", ids(results))
+
+		euros := NewTextIndex("it_IT")
+		euros.Insert(yelp.Business{ID: "pizza-4", Name: "Pizzeria da Michele", Price: "€€"})
+		results = euros.Search(TextQuery{Text: "pizzeria", Price: yelp.PriceLevels{yelp.PriceModerate}})
+		assert(t, reflect.DeepEqual(ids(results), []string{"pizza-4"}), "Expected prices to be compared by currency symbol, not byte: %v", ids(results))
+
+		results = ti.Search(TextQuery{Text: "pizza", Limit: 1})
+		assert(t, len(results) == 1, "Expected results to be limited: %v", ids(results))
+	})
+
+	t.Run("Update and Delete", func(t *testing.T) {
+		renamed := businesses[1]
+		renamed.Name = "Golden Boy Focaccia"
+		ti.Insert(renamed)
+		assert(t, ti.Len() == 3, "Expected updating to not change the size of the index")
+		assert(t, len(ti.Search(TextQuery{Text: "focaccia"})) == 1, "Expected the new name to be indexed")
+		assert(t, len(ti.Search(TextQuery{Text: "golden pizza"})) == 1, "Expected the category to still be indexed")
+
+		assert(t, ti.Delete("pizza-2") && !ti.Delete("pizza-2"), "Expected the business to be deleted once")
+		assert(t, len(ti.Search(TextQuery{Text: "golden"})) == 0, "Expected deleted terms to be removed")
+		assert(t, len(ti.Complete("gol", 5)) == 0, "Expected deleted terms to not be completed")
+	})
+}
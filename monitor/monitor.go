@@ -0,0 +1,252 @@
+// Package monitor periodically refreshes a watchlist of businesses with the Get
+// Business API and publishes the changes between refreshes.
+package monitor
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// DefaultInterval is the interval of businesses watched with an interval of 0
+// or less.
+const DefaultInterval = time.Hour
+
+// Monitor refreshes watched businesses when they are due, earliest first. It
+// makes one request at a time, so refreshes stay within the rate limit of the
+// client, ie. one created with yelp.WithRateLimiter, and fall behind rather
+// than exceed it.
+type Monitor struct {
+	Client yelp.Client
+	// Store persists the last seen snapshots. Defaults to a MemoryStore.
+	Store SnapshotStore
+
+	// OnChange, when set, is called with each change found by a refresh.
+	OnChange func(yelp.Change)
+	// Changes, when set, is sent each change found by a refresh.
+	Changes chan<- yelp.Change
+	// OnError, when set, is called when refreshing a business fails.
+	OnError func(id string, err error)
+
+	// now returns the current time.
+	now func() time.Time
+
+	mu      sync.Mutex
+	queue   watchQueue
+	watches map[string]*watch
+	wake    chan struct{}
+}
+
+// watch is a watched business, when it was last refreshed and when it is next
+// due to be refreshed.
+type watch struct {
+	id        string
+	interval  time.Duration
+	refreshed time.Time
+	due       time.Time
+	index     int
+}
+
+// New returns a Monitor which refreshes businesses with client and persists
+// their snapshots to store. If store is nil, a MemoryStore is used.
+func New(client yelp.Client, store SnapshotStore) *Monitor {
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	return &Monitor{
+		Client: client,
+		Store:  store,
+	}
+}
+
+// Watch adds the business with the given ID to the watchlist, to be refreshed
+// now and then every interval, or DefaultInterval if interval is 0 or less.
+// Watching a business again changes its interval, counting from its last
+// refresh.
+func (m *Monitor) Watch(id string, interval time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.init()
+
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	if w, ok := m.watches[id]; ok {
+		w.interval = interval
+		if !w.refreshed.IsZero() {
+			w.due = w.refreshed.Add(interval)
+			heap.Fix(&m.queue, w.index)
+			m.signal()
+		}
+		return
+	}
+	w := &watch{id: id, interval: interval, due: m.now()}
+	m.watches[id] = w
+	heap.Push(&m.queue, w)
+	m.signal()
+}
+
+// Unwatch removes the business with the given ID from the watchlist.
+func (m *Monitor) Unwatch(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.init()
+
+	if w, ok := m.watches[id]; ok {
+		heap.Remove(&m.queue, w.index)
+		delete(m.watches, id)
+		m.signal()
+	}
+}
+
+// Run refreshes watched businesses as they become due until ctx is done, then
+// returns ctx's error.
+func (m *Monitor) Run(ctx context.Context) error {
+	m.mu.Lock()
+	m.init()
+	m.mu.Unlock()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		id, wait := m.next()
+		if id != "" {
+			m.refresh(ctx, id)
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(wait)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-m.wake:
+		case <-timer.C:
+		}
+	}
+}
+
+// next returns the ID of a business that is due to be refreshed, and
+// reschedules it. If none are due, it returns how long until one is.
+func (m *Monitor) next() (string, time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.queue) == 0 {
+		return "", time.Hour
+	}
+
+	w := m.queue[0]
+	now := m.now()
+	if wait := w.due.Sub(now); wait > 0 {
+		return "", wait
+	}
+	w.refreshed = now
+	w.due = now.Add(w.interval)
+	heap.Fix(&m.queue, 0)
+	return w.id, 0
+}
+
+// refresh gets the business with the given ID, publishes its changes since the
+// last snapshot and then saves the new snapshot. Snapshots are keyed by the ID
+// of the returned business, which differs from the watched ID when a business
+// is watched by alias. The snapshot is not saved if ctx is done before every
+// change is published, so the changes are found again by the next refresh.
+func (m *Monitor) refresh(ctx context.Context, id string) {
+	business, err := m.Client.GetBusiness(ctx, &yelp.GetBusinessOptions{ID: id})
+	if err != nil {
+		m.error(ctx, id, err)
+		return
+	}
+	last, err := m.Store.Get(ctx, business.ID)
+	if err != nil {
+		m.error(ctx, id, err)
+		return
+	}
+
+	if last != nil {
+		for _, change := range yelp.Diff(*last, *business) {
+			if m.OnChange != nil {
+				m.OnChange(change)
+			}
+			if m.Changes != nil {
+				select {
+				case m.Changes <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+
+	if err := m.Store.Put(ctx, *business); err != nil {
+		m.error(ctx, id, err)
+	}
+}
+
+// error reports that refreshing the business with the given ID failed, unless
+// the failure is due to the monitor shutting down.
+func (m *Monitor) error(ctx context.Context, id string, err error) {
+	if m.OnError != nil && ctx.Err() == nil {
+		m.OnError(id, err)
+	}
+}
+
+// init initializes the state of m. m.mu must be held.
+func (m *Monitor) init() {
+	if m.watches != nil {
+		return
+	}
+	m.watches = map[string]*watch{}
+	m.wake = make(chan struct{}, 1)
+	if m.now == nil {
+		m.now = time.Now
+	}
+	if m.Store == nil {
+		m.Store = NewMemoryStore()
+	}
+}
+
+// signal wakes Run to reconsider which business is due next.
+func (m *Monitor) signal() {
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+// watchQueue is a min-heap of watches ordered by when they are due.
+type watchQueue []*watch
+
+func (q watchQueue) Len() int           { return len(q) }
+func (q watchQueue) Less(i, j int) bool { return q[i].due.Before(q[j].due) }
+
+func (q watchQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *watchQueue) Push(x interface{}) {
+	w := x.(*watch)
+	w.index = len(*q)
+	*q = append(*q, w)
+}
+
+func (q *watchQueue) Pop() interface{} {
+	old := *q
+	w := old[len(old)-1]
+	old[len(old)-1] = nil
+	*q = old[:len(old)-1]
+	return w
+}
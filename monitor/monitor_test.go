@@ -0,0 +1,192 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// fakeClient returns businesses whose review count goes up on every request.
+// Businesses requested by one of the aliases are returned with its ID.
+type fakeClient struct {
+	mu       sync.Mutex
+	requests map[string]int
+	aliases  map[string]string
+}
+
+func (f *fakeClient) BusinessSearch(ctx context.Context, bso *yelp.BusinessSearchOptions) (*yelp.BusinessSearchResults, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeClient) GetBusiness(ctx context.Context, gbo *yelp.GetBusinessOptions) (*yelp.Business, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if gbo.ID == "missingno" {
+		return nil, errors.New("404 Not Found")
+	}
+	id := gbo.ID
+	if businessID, ok := f.aliases[id]; ok {
+		id = businessID
+	}
+	f.requests[id]++
+	return &yelp.Business{ID: id, ReviewCount: int64(f.requests[id])}, nil
+}
+
+func (f *fakeClient) count(id string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[id]
+}
+
+func TestMonitor(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &fakeClient{requests: map[string]int{}}
+	changes := make(chan yelp.Change)
+	errs := make(chan string, 10)
+	store := NewMemoryStore()
+	m := New(client, store)
+	m.Changes = changes
+	m.OnError = func(id string, err error) {
+		errs <- id
+	}
+	m.Watch("eevee", 5*time.Millisecond)
+	m.Watch("missingno", time.Hour)
+
+	done := make(chan error)
+	go func() {
+		done <- m.Run(ctx)
+	}()
+
+	for i := 0; i < 3; i++ {
+		select {
+		case c := <-changes:
+			assert(t, c.Type == yelp.ChangeReviewCount && c.BusinessID == "eevee" && c.Delta == 1, "Unexpected change: %+v", c)
+		case <-time.After(time.Second):
+			t.Fatal("Expected review count changes to be published")
+		}
+	}
+	select {
+	case id := <-errs:
+		assert(t, id == "missingno", "Expected an error refreshing missingno, got %s", id)
+	case <-time.After(time.Second):
+		t.Fatal("Expected refresh errors to be reported")
+	}
+
+	// businesses added while running are refreshed without waiting for others
+	m.Watch("jolteon", time.Hour)
+	for client.count("jolteon") == 0 {
+		select {
+		case <-changes:
+		case <-time.After(time.Second):
+			t.Fatal("Expected jolteon to be refreshed")
+		}
+	}
+
+	m.Unwatch("eevee")
+	select {
+	case <-changes:
+	case <-time.After(20 * time.Millisecond):
+	}
+	refreshed := client.count("eevee")
+	time.Sleep(20 * time.Millisecond)
+	assert(t, client.count("eevee") == refreshed, "Expected unwatched businesses to stop being refreshed")
+
+	snapshot, err := store.Get(ctx, "jolteon")
+	assert(t, err == nil && snapshot != nil && snapshot.ID == "jolteon", "Expected the snapshot to be saved: %+v (%v)", snapshot, err)
+
+	cancel()
+	select {
+	case err := <-done:
+		assert(t, err == context.Canceled, "Expected Run to return the context error, got %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("Expected Run to stop when ctx is done")
+	}
+}
+
+func TestMonitorAlias(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &fakeClient{
+		requests: map[string]int{},
+		aliases:  map[string]string{"vaporeon-cerulean-city": "vaporeon"},
+	}
+	changes := make(chan yelp.Change)
+	store := NewMemoryStore()
+	m := New(client, store)
+	m.Changes = changes
+	m.Watch("vaporeon-cerulean-city", 5*time.Millisecond)
+	go m.Run(ctx)
+
+	select {
+	case c := <-changes:
+		assert(t, c.Type == yelp.ChangeReviewCount && c.BusinessID == "vaporeon" && c.Delta == 1, "Unexpected change: %+v", c)
+	case <-time.After(time.Second):
+		t.Fatal("Expected changes to be published for businesses watched by alias")
+	}
+}
+
+func TestMonitorIntervals(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &fakeClient{requests: map[string]int{}}
+	m := New(client, nil)
+	m.Watch("umbreon", 0)
+	m.Watch("espeon", -time.Second)
+	m.Watch("leafeon", time.Hour)
+	go m.Run(ctx)
+
+	for client.count("umbreon") == 0 || client.count("espeon") == 0 || client.count("leafeon") == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	assert(t, client.count("umbreon") == 1 && client.count("espeon") == 1, "Expected non-positive intervals to use the default interval, got %d and %d requests", client.count("umbreon"), client.count("espeon"))
+
+	// a shorter interval takes effect without waiting for the old one
+	m.Watch("leafeon", 5*time.Millisecond)
+	deadline := time.Now().Add(time.Second)
+	for client.count("leafeon") < 3 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected a shorter interval to take effect, got %d requests", client.count("leafeon"))
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestMonitorUnpublishedChanges(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	client := &fakeClient{requests: map[string]int{}}
+	store := NewMemoryStore()
+	store.Put(ctx, yelp.Business{ID: "flareon"})
+
+	// nothing receives the changes, so the refresh waits until ctx is done
+	m := New(client, store)
+	m.Changes = make(chan yelp.Change)
+	m.Watch("flareon", time.Hour)
+	done := make(chan error)
+	go func() {
+		done <- m.Run(ctx)
+	}()
+	for client.count("flareon") == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	snapshot, err := store.Get(context.Background(), "flareon")
+	assert(t, err == nil && snapshot.ReviewCount == 0, "Expected the snapshot to not be saved before its changes are published: %+v (%v)", snapshot, err)
+}
+
+func assert(t *testing.T, condition bool, assertionFormat string, values ...interface{}) {
+	if !condition {
+		t.Fatalf(assertionFormat, values...)
+	}
+}
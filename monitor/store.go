@@ -0,0 +1,47 @@
+package monitor
+
+import (
+	"context"
+	"sync"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// SnapshotStore persists the last seen snapshot of each watched business.
+type SnapshotStore interface {
+	// Get returns the saved snapshot of the business with the given ID, or nil
+	// if there is none.
+	Get(ctx context.Context, id string) (*yelp.Business, error)
+	// Put saves a snapshot of b, replacing any snapshot with the same ID.
+	Put(ctx context.Context, b yelp.Business) error
+}
+
+// MemoryStore is a SnapshotStore which keeps snapshots in memory.
+type MemoryStore struct {
+	mu        sync.RWMutex
+	snapshots map[string]yelp.Business
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{snapshots: map[string]yelp.Business{}}
+}
+
+// Get implements the SnapshotStore interface.
+func (ms *MemoryStore) Get(ctx context.Context, id string) (*yelp.Business, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	b, ok := ms.snapshots[id]
+	if !ok {
+		return nil, nil
+	}
+	return &b, nil
+}
+
+// Put implements the SnapshotStore interface.
+func (ms *MemoryStore) Put(ctx context.Context, b yelp.Business) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.snapshots[b.ID] = b
+	return nil
+}
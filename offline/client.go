@@ -0,0 +1,260 @@
+// Package offline implements yelp.Client without the Yelp API, answering
+// requests from a local set of businesses such as the Yelp Open Dataset.
+package offline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// Defaults of the Business Search API.
+const (
+	defaultLimit  = 20
+	defaultRadius = 40000
+)
+
+// Client implements the yelp.ExtendedClient interface.
+var _ yelp.ExtendedClient = (*Client)(nil)
+
+// Client answers Business Search and Get Business requests locally.
+type Client struct {
+	businesses []yelp.Business
+	byID       map[string]int
+
+	// Timezone is the timezone that business hours are evaluated in for the
+	// `OpenNow` and `OpenAt` options, since the Open Dataset does not include
+	// one. Defaults to time.Local.
+	Timezone *time.Location
+
+	// now returns the current time, for `OpenNow`.
+	now func() time.Time
+}
+
+// New returns a Client for the given businesses.
+func New(businesses []yelp.Business) *Client {
+	c := &Client{
+		businesses: businesses,
+		byID:       make(map[string]int, len(businesses)),
+		now:        time.Now,
+	}
+	for i, b := range businesses {
+		c.byID[b.ID] = i
+	}
+	return c
+}
+
+// GetBusiness returns the business with the ID of the options.
+func (c *Client) GetBusiness(ctx context.Context, gbo *yelp.GetBusinessOptions) (*yelp.Business, error) {
+	if err := gbo.Validate(); err != nil {
+		return nil, err
+	}
+	i, ok := c.byID[gbo.ID]
+	if !ok {
+		return nil, fmt.Errorf("404 Not Found: business %s does not exist", gbo.ID)
+	}
+	b := c.businesses[i]
+	return &b, nil
+}
+
+// match is a business matching a search, with its distance from the search.
+type match struct {
+	business yelp.Business
+	distance float64
+}
+
+// BusinessSearch returns the businesses matching the options. Searches by
+// `Location` match businesses whose city, state or zip code is contained in
+// the location, and distances are measured from the center of those
+// businesses. The `Attributes` and `Locale` options are not supported.
+func (c *Client) BusinessSearch(ctx context.Context, bso *yelp.BusinessSearchOptions) (*yelp.BusinessSearchResults, error) {
+	if err := bso.Validate(); err != nil {
+		return nil, err
+	}
+	if len(bso.Attributes) > 0 {
+		return nil, errors.New("BusinessSearchOptions `Attributes` are not supported offline")
+	}
+
+	openAt, err := c.openAt(bso)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []yelp.Business
+	for _, b := range c.businesses {
+		if !b.IsClosed && matchesLocation(b, bso.Location) && matchesTerm(b, bso.Term) &&
+			matchesCategories(b, bso.Categories) && bso.Price.Matches(b.Price) {
+			candidates = append(candidates, b)
+		}
+	}
+
+	center := searchCenter(bso, candidates)
+	radius := float64(defaultRadius)
+	if bso.Radius != nil {
+		radius = float64(*bso.Radius)
+	}
+
+	var matches []match
+	for _, b := range candidates {
+		distance := center.DistanceTo(b.Coodinates)
+		if bso.Coordinates != nil && distance > radius {
+			continue
+		}
+		if openAt != nil {
+			schedule, err := b.Schedule(c.timezone())
+			if err != nil || !schedule.IsOpenAt(*openAt) {
+				continue
+			}
+		}
+		matches = append(matches, match{business: b, distance: distance})
+	}
+	sortMatches(matches, bso.SortBy)
+
+	results := &yelp.BusinessSearchResults{
+		Total:      int64(len(matches)),
+		Businesses: []yelp.Business{},
+		Region:     yelp.Region{Center: center},
+	}
+	offset, limit := yelp.Int64Value(bso.Offset), int64(defaultLimit)
+	if bso.Limit != nil {
+		limit = *bso.Limit
+	}
+	for i := offset; i < offset+limit && i < int64(len(matches)); i++ {
+		b := matches[i].business
+		b.Distance = matches[i].distance
+		results.Businesses = append(results.Businesses, b)
+	}
+	return results, nil
+}
+
+// openAt returns the time businesses must be open at, if any.
+func (c *Client) openAt(bso *yelp.BusinessSearchOptions) (*time.Time, error) {
+	switch {
+	case yelp.BoolValue(bso.OpenNow):
+		now := c.now()
+		return &now, nil
+	case bso.OpenAt != nil:
+		t := time.Unix(*bso.OpenAt, 0)
+		return &t, nil
+	default:
+		return nil, nil
+	}
+}
+
+// timezone returns the timezone that business hours are evaluated in.
+func (c *Client) timezone() *time.Location {
+	if c.Timezone == nil {
+		return time.Local
+	}
+	return c.Timezone
+}
+
+// searchCenter returns the coordinates of the search, or the center of the
+// businesses found for a location search.
+func searchCenter(bso *yelp.BusinessSearchOptions, businesses []yelp.Business) yelp.Coordinates {
+	if bso.Coordinates != nil {
+		return *bso.Coordinates
+	}
+	if len(businesses) == 0 {
+		return yelp.Coordinates{}
+	}
+	box := yelp.BoundingBox{SouthWest: businesses[0].Coodinates, NorthEast: businesses[0].Coodinates}
+	for _, b := range businesses[1:] {
+		box = box.Expand(b.Coodinates)
+	}
+	return box.Center()
+}
+
+// matchesLocation returns whether the city, state or zip code of b is contained
+// in location.
+func matchesLocation(b yelp.Business, location *string) bool {
+	if location == nil {
+		return true
+	}
+	loc := strings.ToLower(*location)
+	for _, field := range []string{b.Location.City, b.Location.ZipCode} {
+		if field != "" && strings.Contains(loc, strings.ToLower(field)) {
+			return true
+		}
+	}
+	// state codes are only matched as whole words, ie. "CA" in "Oakland, CA"
+	for _, word := range strings.FieldsFunc(loc, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if b.Location.State != "" && word == strings.ToLower(b.Location.State) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesTerm returns whether every word of term is in the name or categories
+// of b.
+func matchesTerm(b yelp.Business, term *string) bool {
+	if term == nil {
+		return true
+	}
+	text := strings.ToLower(b.Name)
+	for _, c := range b.Categories {
+		text += " " + strings.ToLower(c.Title) + " " + c.Alias
+	}
+	for _, word := range strings.Fields(strings.ToLower(*term)) {
+		if !strings.Contains(text, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesCategories returns whether b has any of the category aliases.
+func matchesCategories(b yelp.Business, categories []string) bool {
+	if len(categories) == 0 {
+		return true
+	}
+	for _, c := range b.Categories {
+		for _, alias := range categories {
+			if c.Alias == alias {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sortMatches sorts the matches in the given order, defaulting to best match.
+// Best match is approximated as the highest rated and most reviewed nearby
+// businesses.
+func sortMatches(matches []match, sortBy *yelp.SortBy) {
+	order := yelp.SortByBestMatch
+	if sortBy != nil {
+		order = *sortBy
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		switch order {
+		case yelp.SortByDistance:
+			return a.distance < b.distance
+		case yelp.SortByReviewCount:
+			return a.business.ReviewCount > b.business.ReviewCount
+		case yelp.SortByRating:
+			if a.business.Rating != b.business.Rating {
+				return a.business.Rating > b.business.Rating
+			}
+			return a.business.ReviewCount > b.business.ReviewCount
+		default:
+			return bestMatchScore(a) > bestMatchScore(b)
+		}
+	})
+}
+
+// bestMatchScore scores a match by its rating weighted by its number of reviews,
+// penalized by its distance.
+func bestMatchScore(m match) float64 {
+	reviews := float64(m.business.ReviewCount)
+	weighted := (m.business.Rating*reviews + 3*10) / (reviews + 10)
+	return weighted - m.distance/10000
+}
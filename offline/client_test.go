@@ -0,0 +1,291 @@
+package offline
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+const testDataset = `{"business_id":"garaje","name":"Garaje","address":"475 3rd St","city":"San Francisco","state":"CA","postal_code":"94107","latitude":37.7817,"longitude":-122.3961,"stars":4.5,"review_count":1198,"is_open":1,"attributes":{"RestaurantsPriceRange2":"1"},"categories":"Mexican, Burgers, Gastropubs","hours":{"Monday":"10:0-21:0","Friday":"18:0-2:0"}}
+{"business_id":"state-bird","name":"State Bird Provisions","address":"1529 Fillmore St","city":"San Francisco","state":"CA","postal_code":"94115","latitude":37.7838,"longitude":-122.4329,"stars":4.0,"review_count":4000,"is_open":1,"attributes":{"RestaurantsPriceRange2":"3"},"categories":"American (New), Breakfast & Brunch","hours":null}
+
+{"business_id":"closed","name":"Closed Burgers","address":"1 Main St","city":"San Francisco","state":"CA","postal_code":"94107","latitude":37.78,"longitude":-122.39,"stars":5,"review_count":10,"is_open":0,"attributes":null,"categories":"Burgers","hours":null}
+{"business_id":"oakland","name":"Oakland Tacos","address":"","city":"Oakland","state":"CA","postal_code":"94612","latitude":37.8044,"longitude":-122.2712,"stars":3.5,"review_count":50,"is_open":1,"attributes":null,"categories":null,"hours":{"Sunday":"0:0-0:0"}}
+`
+
+func TestLoad(t *testing.T) {
+	c, err := Load(strings.NewReader(testDataset))
+	assert(t, err == nil, "Expected no error (%v) loading the dataset", err)
+
+	b, err := c.GetBusiness(context.Background(), &yelp.GetBusinessOptions{ID: "garaje"})
+	assert(t, err == nil, "Expected no error (%v) getting a business", err)
+	assert(t, b.Name == "Garaje" && b.Price == "$" && b.Rating == 4.5 && !b.IsClosed, "Unexpected business: %+v", b)
+	assert(t, b.Location.ZipCode == "94107" && b.Location.DisplayAddress[1] == "San Francisco, CA 94107", "Unexpected location: %+v", b.Location)
+	assert(t, b.Categories[0].Alias == "mexican" && b.Categories[2].Title == "Gastropubs", "Unexpected categories: %+v", b.Categories)
+	assert(t, len(b.Hours) == 1 && len(b.Hours[0].Open) == 2, "Unexpected hours: %+v", b.Hours)
+	friday := b.Hours[0].Open[1]
+	assert(t, friday.Day == 4 && friday.Start == "1800" && friday.End == "0200" && friday.IsOvernight, "Unexpected Friday hours: %+v", friday)
+
+	sb, _ := c.GetBusiness(context.Background(), &yelp.GetBusinessOptions{ID: "state-bird"})
+	assert(t, sb.Categories[1].Alias == "breakfast_brunch", "Expected breakfast_brunch alias, got %s", sb.Categories[1].Alias)
+
+	_, err = c.GetBusiness(context.Background(), &yelp.GetBusinessOptions{ID: "missing"})
+	assert(t, err != nil, "Expected an error for a missing business")
+
+	_, err = Load(strings.NewReader(`{"business_id":"bad","hours":{"Monday":"noon"}}`))
+	assert(t, err != nil, "Expected an error for invalid hours")
+}
+
+func TestBusinessSearch(t *testing.T) {
+	ctx := context.Background()
+	c, err := Load(strings.NewReader(testDataset))
+	assert(t, err == nil, "Expected no error (%v) loading the dataset", err)
+	c.Timezone = time.UTC
+
+	ids := func(results *yelp.BusinessSearchResults) string {
+		var ids []string
+		for _, b := range results.Businesses {
+			ids = append(ids, b.ID)
+		}
+		return strings.Join(ids, ",")
+	}
+
+	t.Run("Invalid options", func(t *testing.T) {
+		_, err := c.BusinessSearch(ctx, &yelp.BusinessSearchOptions{})
+		assert(t, err != nil, "Expected an error for invalid options")
+	})
+
+	t.Run("Location", func(t *testing.T) {
+		results, err := c.BusinessSearch(ctx, &yelp.BusinessSearchOptions{
+			Location: yelp.StringPointer("San Francisco, CA"),
+			SortBy:   yelp.SortByPointer(yelp.SortByReviewCount),
+		})
+		assert(t, err == nil, "Expected no error (%v) searching", err)
+		assert(t, results.Total == 3 && ids(results) == "state-bird,garaje,oakland", "Unexpected results: %s", ids(results))
+	})
+
+	t.Run("Term, categories and price", func(t *testing.T) {
+		results, _ := c.BusinessSearch(ctx, &yelp.BusinessSearchOptions{
+			Location: yelp.StringPointer("94107"),
+			Term:     yelp.StringPointer("burgers"),
+		})
+		assert(t, ids(results) == "garaje", "Expected closed businesses to be excluded: %s", ids(results))
+
+		results, _ = c.BusinessSearch(ctx, &yelp.BusinessSearchOptions{
+			Location:   yelp.StringPointer("San Francisco"),
+			Categories: []string{"breakfast_brunch", "tacos"},
+			Price:      yelp.PriceLevels{yelp.PricePricey},
+		})
+		assert(t, ids(results) == "state-bird", "Unexpected results: %s", ids(results))
+	})
+
+	t.Run("Non-dollar prices", func(t *testing.T) {
+		euros := New([]yelp.Business{{ID: "da-michele", Name: "Pizzeria da Michele", Price: "€€", Location: yelp.Location{City: "Napoli"}}})
+		results, err := euros.BusinessSearch(ctx, &yelp.BusinessSearchOptions{
+			Location: yelp.StringPointer("Napoli"),
+			Price:    yelp.PriceLevels{yelp.PriceModerate},
+		})
+		assert(t, err == nil && ids(results) == "da-michele", "Expected prices to be compared by currency symbol, not byte: %s (%v)", ids(results), err)
+	})
+
+	t.Run("Radius and distance", func(t *testing.T) {
+		results, _ := c.BusinessSearch(ctx, &yelp.BusinessSearchOptions{
+			Coordinates: &yelp.Coordinates{Latitude: 37.7817, Longitude: -122.3961},
+			Radius:      yelp.Int64Pointer(5000),
+			SortBy:      yelp.SortByPointer(yelp.SortByDistance),
+		})
+		assert(t, ids(results) == "garaje,state-bird", "Unexpected results: %s", ids(results))
+		assert(t, results.Businesses[0].Distance == 0 && results.Businesses[1].Distance > 3000, "Unexpected distances: %+v", results.Businesses)
+	})
+
+	t.Run("Open at", func(t *testing.T) {
+		// 2019-11-30 01:00 UTC is a Saturday, during Garaje's Friday night hours
+		results, _ := c.BusinessSearch(ctx, &yelp.BusinessSearchOptions{
+			Location: yelp.StringPointer("CA"),
+			OpenAt:   yelp.Int64Pointer(time.Date(2019, time.November, 30, 1, 0, 0, 0, time.UTC).Unix()),
+		})
+		assert(t, ids(results) == "garaje", "Unexpected results: %s", ids(results))
+
+		c.now = func() time.Time { return time.Date(2019, time.December, 1, 12, 0, 0, 0, time.UTC) }
+		results, _ = c.BusinessSearch(ctx, &yelp.BusinessSearchOptions{
+			Location: yelp.StringPointer("CA"),
+			OpenNow:  yelp.BoolPointer(true),
+		})
+		assert(t, ids(results) == "oakland", "Unexpected results: %s", ids(results))
+	})
+
+	t.Run("Limit and offset", func(t *testing.T) {
+		results, _ := c.BusinessSearch(ctx, &yelp.BusinessSearchOptions{
+			Location: yelp.StringPointer("CA"),
+			SortBy:   yelp.SortByPointer(yelp.SortByRating),
+			Limit:    yelp.Int64Pointer(1),
+			Offset:   yelp.Int64Pointer(1),
+		})
+		assert(t, results.Total == 3 && ids(results) == "state-bird", "Unexpected results: %s", ids(results))
+	})
+}
+
+func TestReviews(t *testing.T) {
+	c := New(nil)
+	for _, tc := range []struct {
+		name    string
+		options *yelp.ReviewsOptions
+		err     string
+	}{
+		{"Invalid options", &yelp.ReviewsOptions{}, "ReviewsOptions"},
+		{"Unsupported endpoint", &yelp.ReviewsOptions{ID: "garaje"}, "not supported offline"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := c.Reviews(context.Background(), tc.options)
+			assert(t, err != nil && strings.Contains(err.Error(), tc.err), "Expected an error containing %q, got %v", tc.err, err)
+		})
+	}
+}
+
+func TestBusinessMatch(t *testing.T) {
+	c, err := Load(strings.NewReader(testDataset))
+	assert(t, err == nil, "Expected no error (%v) loading the dataset", err)
+
+	options := func(name, city string) *yelp.BusinessMatchOptions {
+		return &yelp.BusinessMatchOptions{Name: name, Address1: "1 Main St", City: city, State: "ca", Country: "US"}
+	}
+	for _, tc := range []struct {
+		name     string
+		options  *yelp.BusinessMatchOptions
+		expected string
+		err      bool
+	}{
+		{"Invalid options", &yelp.BusinessMatchOptions{Name: "Garaje"}, "", true},
+		{"Name, city and state ignoring case", options("GARAJE", "san francisco"), "garaje", false},
+		{"Not found", options("Garaje", "Oakland"), "", false},
+		{"Yelp business ID", func() *yelp.BusinessMatchOptions {
+			o := options("Tacos", "Oakland")
+			o.YelpBusinessID = yelp.StringPointer("oakland")
+			return o
+		}(), "oakland", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := c.BusinessMatch(context.Background(), tc.options)
+			if tc.err {
+				assert(t, err != nil, "Expected an error for invalid options")
+				return
+			}
+			assert(t, err == nil, "Expected no error (%v) matching", err)
+			var ids []string
+			for _, b := range results.Businesses {
+				ids = append(ids, b.ID)
+				assert(t, b.Rating == 0 && b.Categories == nil, "Expected only the matched fields to be set: %+v", b)
+			}
+			assert(t, strings.Join(ids, ",") == tc.expected, "Expected %q to be matched, got %v", tc.expected, ids)
+		})
+	}
+}
+
+func TestPhoneSearch(t *testing.T) {
+	c := New([]yelp.Business{{ID: "garaje", Phone: "+14156440838"}, {ID: "state-bird"}})
+	for _, tc := range []struct {
+		name     string
+		phone    string
+		expected string
+		err      bool
+	}{
+		{"Invalid options", "4156440838", "", true},
+		{"Found", "+14156440838", "garaje", false},
+		{"Not found", "+14155550100", "", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := c.PhoneSearch(context.Background(), &yelp.PhoneSearchOptions{Phone: tc.phone})
+			if tc.err {
+				assert(t, err != nil, "Expected an error for invalid options")
+				return
+			}
+			assert(t, err == nil, "Expected no error (%v) searching", err)
+			var ids []string
+			for _, b := range results.Businesses {
+				ids = append(ids, b.ID)
+			}
+			assert(t, strings.Join(ids, ",") == tc.expected && int(results.Total) == len(ids), "Expected %q to be found, got %v (%d)", tc.expected, ids, results.Total)
+		})
+	}
+}
+
+func TestAutocomplete(t *testing.T) {
+	c, err := Load(strings.NewReader(testDataset))
+	assert(t, err == nil, "Expected no error (%v) loading the dataset", err)
+
+	near := &yelp.Coordinates{Latitude: 37.7817, Longitude: -122.3961}
+	for _, tc := range []struct {
+		name       string
+		options    *yelp.AutocompleteOptions
+		categories string
+		businesses string
+		err        bool
+	}{
+		{"Invalid options", &yelp.AutocompleteOptions{}, "", "", true},
+		{"Categories", &yelp.AutocompleteOptions{Text: "BUR"}, "burgers", "", false},
+		{"Nearby businesses", &yelp.AutocompleteOptions{Text: "bur", Coordinates: near}, "burgers", "", false},
+		{"Business words", &yelp.AutocompleteOptions{Text: "prov", Coordinates: near}, "", "state-bird", false},
+		{"Not found", &yelp.AutocompleteOptions{Text: "pokeball", Coordinates: near}, "", "", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := c.Autocomplete(context.Background(), tc.options)
+			if tc.err {
+				assert(t, err != nil, "Expected an error for invalid options")
+				return
+			}
+			assert(t, err == nil, "Expected no error (%v) completing", err)
+			var categories, businesses []string
+			for _, category := range results.Categories {
+				categories = append(categories, category.Alias)
+			}
+			for _, b := range results.Businesses {
+				businesses = append(businesses, b.ID)
+			}
+			assert(t, strings.Join(categories, ",") == tc.categories, "Expected categories %q, got %v", tc.categories, categories)
+			assert(t, strings.Join(businesses, ",") == tc.businesses, "Expected businesses %q, got %v", tc.businesses, businesses)
+			assert(t, len(results.Terms) == 0, "Expected no terms to be suggested: %v", results.Terms)
+		})
+	}
+}
+
+func TestCategories(t *testing.T) {
+	c, err := Load(strings.NewReader(testDataset))
+	assert(t, err == nil, "Expected no error (%v) loading the dataset", err)
+
+	results, err := c.Categories(context.Background(), nil)
+	assert(t, err == nil, "Expected no error (%v) listing categories", err)
+	var aliases []string
+	for _, category := range results.Categories {
+		aliases = append(aliases, category.Alias)
+	}
+	assert(t, strings.Join(aliases, ",") == "american_new,breakfast_brunch,burgers,gastropubs,mexican", "Expected distinct categories sorted by alias, got %v", aliases)
+
+	for _, tc := range []struct {
+		name    string
+		options *yelp.GetCategoryOptions
+		title   string
+		err     string
+	}{
+		{"Invalid options", &yelp.GetCategoryOptions{}, "", "GetCategoryOptions"},
+		{"Found", &yelp.GetCategoryOptions{Alias: "burgers"}, "Burgers", ""},
+		{"Not found", &yelp.GetCategoryOptions{Alias: "hotdogs"}, "", "404 Not Found"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			category, err := c.GetCategory(context.Background(), tc.options)
+			if tc.err != "" {
+				assert(t, err != nil && strings.Contains(err.Error(), tc.err), "Expected an error containing %q, got %v", tc.err, err)
+				return
+			}
+			assert(t, err == nil && category.Title == tc.title, "Expected the %s category, got %+v (%v)", tc.title, category, err)
+		})
+	}
+}
+
+func assert(t *testing.T, condition bool, assertionFormat string, values ...interface{}) {
+	if !condition {
+		t.Fatalf(assertionFormat, values...)
+	}
+}
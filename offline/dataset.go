@@ -0,0 +1,180 @@
+package offline
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// datasetDays are the days of the Open Dataset hours, in Yelp's day numbering.
+var datasetDays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+// datasetBusiness is a line of the Open Dataset business.json file.
+type datasetBusiness struct {
+	BusinessID  string                 `json:"business_id"`
+	Name        string                 `json:"name"`
+	Address     string                 `json:"address"`
+	City        string                 `json:"city"`
+	State       string                 `json:"state"`
+	PostalCode  string                 `json:"postal_code"`
+	Latitude    float64                `json:"latitude"`
+	Longitude   float64                `json:"longitude"`
+	Stars       float64                `json:"stars"`
+	ReviewCount int64                  `json:"review_count"`
+	IsOpen      int                    `json:"is_open"`
+	Attributes  map[string]interface{} `json:"attributes"`
+	Categories  *string                `json:"categories"`
+	Hours       map[string]string      `json:"hours"`
+}
+
+// LoadFile returns a Client for the Open Dataset business.json file at path.
+func LoadFile(path string) (*Client, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Load(f)
+}
+
+// Load returns a Client for the businesses read from r, which contains one
+// Open Dataset business JSON object per line.
+func Load(r io.Reader) (*Client, error) {
+	var businesses []yelp.Business
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		var db datasetBusiness
+		if err := json.Unmarshal(scanner.Bytes(), &db); err != nil {
+			return nil, fmt.Errorf("invalid business on line %d: %v", line, err)
+		}
+		b, err := db.business()
+		if err != nil {
+			return nil, fmt.Errorf("invalid business on line %d: %v", line, err)
+		}
+		businesses = append(businesses, b)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return New(businesses), nil
+}
+
+// business converts db to a yelp.Business.
+func (db datasetBusiness) business() (yelp.Business, error) {
+	b := yelp.Business{
+		ID:   db.BusinessID,
+		Name: db.Name,
+		Location: yelp.Location{
+			Address1: db.Address,
+			City:     db.City,
+			State:    db.State,
+			ZipCode:  db.PostalCode,
+		},
+		Coodinates: yelp.Coordinates{
+			Latitude:  db.Latitude,
+			Longitude: db.Longitude,
+		},
+		Rating:      db.Stars,
+		ReviewCount: db.ReviewCount,
+		IsClosed:    db.IsOpen == 0,
+		Attributes:  db.Attributes,
+	}
+
+	if db.Address != "" {
+		b.Location.DisplayAddress = append(b.Location.DisplayAddress, db.Address)
+	}
+	b.Location.DisplayAddress = append(b.Location.DisplayAddress, strings.TrimSpace(fmt.Sprintf("%s, %s %s", db.City, db.State, db.PostalCode)))
+
+	if price, ok := db.Attributes["RestaurantsPriceRange2"].(string); ok {
+		if n, err := strconv.Atoi(price); err == nil && n >= 1 && n <= 4 {
+			b.Price = strings.Repeat("$", n)
+		}
+	}
+
+	if db.Categories != nil {
+		for _, title := range strings.Split(*db.Categories, ",") {
+			if title = strings.TrimSpace(title); title != "" {
+				b.Categories = append(b.Categories, yelp.Category{
+					Alias: categoryAlias(title),
+					Title: title,
+				})
+			}
+		}
+	}
+
+	if len(db.Hours) > 0 {
+		hours := yelp.Hours{HoursType: yelp.HoursTypeRegular}
+		for day, name := range datasetDays {
+			span, ok := db.Hours[name]
+			if !ok {
+				continue
+			}
+			open, err := parseDatasetHours(day, span)
+			if err != nil {
+				return b, err
+			}
+			hours.Open = append(hours.Open, open)
+		}
+		b.Hours = []yelp.Hours{hours}
+	}
+	return b, nil
+}
+
+// categoryAlias approximates the Yelp alias of a category title, which the
+// Open Dataset does not include, ie. "Breakfast & Brunch" is breakfast_brunch.
+func categoryAlias(title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	})
+	return strings.Join(words, "_")
+}
+
+// parseDatasetHours parses Open Dataset hours such as "9:0-17:30".
+func parseDatasetHours(day int, span string) (yelp.Open, error) {
+	parts := strings.Split(span, "-")
+	if len(parts) != 2 {
+		return yelp.Open{}, fmt.Errorf("invalid hours %q", span)
+	}
+	start, err := parseDatasetTime(parts[0])
+	if err != nil {
+		return yelp.Open{}, err
+	}
+	end, err := parseDatasetTime(parts[1])
+	if err != nil {
+		return yelp.Open{}, err
+	}
+	return yelp.Open{
+		Day:         day,
+		Start:       start,
+		End:         end,
+		IsOvernight: end <= start,
+	}, nil
+}
+
+// parseDatasetTime converts an Open Dataset time such as "9:0" to Yelp's "HHMM"
+// format.
+func parseDatasetTime(t string) (string, error) {
+	parts := strings.Split(t, ":")
+	if len(parts) != 2 {
+		return "", fmt.Errorf("invalid time %q", t)
+	}
+	hour, err := strconv.Atoi(parts[0])
+	if err != nil || hour < 0 || hour > 24 {
+		return "", fmt.Errorf("invalid time %q", t)
+	}
+	min, err := strconv.Atoi(parts[1])
+	if err != nil || min < 0 || min > 59 {
+		return "", fmt.Errorf("invalid time %q", t)
+	}
+	return fmt.Sprintf("%02d%02d", hour, min), nil
+}
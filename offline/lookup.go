@@ -0,0 +1,175 @@
+package offline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// Defaults of the Business Match and Autocomplete APIs.
+const (
+	defaultMatchLimit       = 3
+	autocompleteSuggestions = 3
+)
+
+// Reviews returns an error, since the businesses of the Open Dataset do not
+// include their reviews.
+func (c *Client) Reviews(ctx context.Context, ro *yelp.ReviewsOptions) (*yelp.ReviewsResults, error) {
+	if err := ro.Validate(); err != nil {
+		return nil, err
+	}
+	return nil, errors.New("Reviews are not supported offline")
+}
+
+// BusinessMatch returns the businesses with the name, city and state of the
+// options, ignoring case, or the business with the `YelpBusinessID` if it
+// exists. Like the Business Match API, only the ID, alias, name, location,
+// coordinates and phone of the businesses are set.
+func (c *Client) BusinessMatch(ctx context.Context, bmo *yelp.BusinessMatchOptions) (*yelp.BusinessMatchResults, error) {
+	if err := bmo.Validate(); err != nil {
+		return nil, err
+	}
+	limit := defaultMatchLimit
+	if bmo.Limit != nil {
+		limit = int(*bmo.Limit)
+	}
+
+	results := &yelp.BusinessMatchResults{Businesses: []yelp.Business{}}
+	if i, ok := c.byID[yelp.StringValue(bmo.YelpBusinessID)]; ok {
+		results.Businesses = append(results.Businesses, matchedBusiness(c.businesses[i]))
+		return results, nil
+	}
+	for _, b := range c.businesses {
+		if len(results.Businesses) == limit {
+			break
+		}
+		if strings.EqualFold(b.Name, bmo.Name) && strings.EqualFold(b.Location.City, bmo.City) &&
+			strings.EqualFold(b.Location.State, bmo.State) &&
+			(b.Location.Country == "" || strings.EqualFold(b.Location.Country, bmo.Country)) {
+			results.Businesses = append(results.Businesses, matchedBusiness(b))
+		}
+	}
+	return results, nil
+}
+
+// matchedBusiness returns the fields of b returned by the Business Match API.
+func matchedBusiness(b yelp.Business) yelp.Business {
+	return yelp.Business{
+		ID:         b.ID,
+		Alias:      b.Alias,
+		Name:       b.Name,
+		Location:   b.Location,
+		Coodinates: b.Coodinates,
+		Phone:      b.Phone,
+	}
+}
+
+// PhoneSearch returns the businesses with the phone number of the options. The
+// businesses of the Open Dataset do not include phone numbers, so only
+// businesses given to New with one can be found.
+func (c *Client) PhoneSearch(ctx context.Context, pso *yelp.PhoneSearchOptions) (*yelp.BusinessSearchResults, error) {
+	if err := pso.Validate(); err != nil {
+		return nil, err
+	}
+	results := &yelp.BusinessSearchResults{Businesses: []yelp.Business{}}
+	for _, b := range c.businesses {
+		if b.Phone == pso.Phone {
+			results.Businesses = append(results.Businesses, b)
+		}
+	}
+	results.Total = int64(len(results.Businesses))
+	return results, nil
+}
+
+// Autocomplete suggests the categories, and the businesses within the default
+// search radius of the `Coordinates`, with a word starting with the text of the
+// options. No search terms are suggested.
+func (c *Client) Autocomplete(ctx context.Context, ao *yelp.AutocompleteOptions) (*yelp.AutocompleteResults, error) {
+	if err := ao.Validate(); err != nil {
+		return nil, err
+	}
+	prefix := strings.ToLower(ao.Text)
+	results := &yelp.AutocompleteResults{
+		Terms:      []yelp.AutocompleteTerm{},
+		Businesses: []yelp.AutocompleteBusiness{},
+		Categories: []yelp.Category{},
+	}
+
+	for _, category := range c.categories() {
+		if len(results.Categories) < autocompleteSuggestions && hasWordPrefix(category.Title, prefix) {
+			results.Categories = append(results.Categories, yelp.Category{Alias: category.Alias, Title: category.Title})
+		}
+	}
+	if ao.Coordinates == nil {
+		return results, nil
+	}
+	for _, b := range c.businesses {
+		if len(results.Businesses) == autocompleteSuggestions {
+			break
+		}
+		if !b.IsClosed && hasWordPrefix(b.Name, prefix) && ao.Coordinates.DistanceTo(b.Coodinates) <= defaultRadius {
+			results.Businesses = append(results.Businesses, yelp.AutocompleteBusiness{ID: b.ID, Name: b.Name})
+		}
+	}
+	return results, nil
+}
+
+// hasWordPrefix returns whether any word of s starts with the lower case prefix.
+func hasWordPrefix(s, prefix string) bool {
+	for _, word := range strings.Fields(strings.ToLower(s)) {
+		if strings.HasPrefix(word, prefix) {
+			return true
+		}
+	}
+	return strings.HasPrefix(strings.ToLower(s), prefix)
+}
+
+// Categories returns the categories of the businesses, sorted by alias. Their
+// parents and countries are not known.
+func (c *Client) Categories(ctx context.Context, co *yelp.CategoriesOptions) (*yelp.CategoriesResults, error) {
+	if err := co.Validate(); err != nil {
+		return nil, err
+	}
+	return &yelp.CategoriesResults{Categories: c.categories()}, nil
+}
+
+// GetCategory returns the category of the businesses with the alias of the
+// options.
+func (c *Client) GetCategory(ctx context.Context, gco *yelp.GetCategoryOptions) (*yelp.CategoryDetails, error) {
+	if err := gco.Validate(); err != nil {
+		return nil, err
+	}
+	for _, category := range c.categories() {
+		if category.Alias == gco.Alias {
+			return &category, nil
+		}
+	}
+	return nil, fmt.Errorf("404 Not Found: category %s does not exist", gco.Alias)
+}
+
+// categories returns the distinct categories of the businesses, sorted by alias.
+func (c *Client) categories() []yelp.CategoryDetails {
+	seen := map[string]bool{}
+	categories := []yelp.CategoryDetails{}
+	for _, b := range c.businesses {
+		for _, category := range b.Categories {
+			if seen[category.Alias] {
+				continue
+			}
+			seen[category.Alias] = true
+			categories = append(categories, yelp.CategoryDetails{
+				Alias:         category.Alias,
+				Title:         category.Title,
+				ParentAliases: []string{},
+			})
+		}
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].Alias < categories[j].Alias
+	})
+	return categories
+}
@@ -0,0 +1,85 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// Column is a named value of a business, flattened to a string.
+type Column struct {
+	Name  string
+	Value func(yelp.Business) string
+}
+
+// AllColumns are every available column, keyed by name.
+var AllColumns = map[string]Column{}
+
+// DefaultColumns are the columns written when none are specified.
+var DefaultColumns []Column
+
+func init() {
+	for _, c := range []Column{
+		{"id", func(b yelp.Business) string { return b.ID }},
+		{"alias", func(b yelp.Business) string { return yelp.StringValue(b.Alias) }},
+		{"name", func(b yelp.Business) string { return b.Name }},
+		{"rating", func(b yelp.Business) string { return yelp.FloatString(b.Rating) }},
+		{"review_count", func(b yelp.Business) string { return yelp.IntString(b.ReviewCount) }},
+		{"price", func(b yelp.Business) string { return b.Price }},
+		{"phone", func(b yelp.Business) string { return b.Phone }},
+		{"display_phone", func(b yelp.Business) string { return b.DisplayPhone }},
+		{"is_closed", func(b yelp.Business) string { return yelp.BoolString(b.IsClosed) }},
+		{"distance", func(b yelp.Business) string { return yelp.FloatString(b.Distance) }},
+		{"address1", func(b yelp.Business) string { return b.Location.Address1 }},
+		{"address2", func(b yelp.Business) string { return b.Location.Address2 }},
+		{"address3", func(b yelp.Business) string { return b.Location.Address3 }},
+		{"address", func(b yelp.Business) string { return strings.Join(b.Location.DisplayAddress, ", ") }},
+		{"city", func(b yelp.Business) string { return b.Location.City }},
+		{"state", func(b yelp.Business) string { return b.Location.State }},
+		{"zip_code", func(b yelp.Business) string { return b.Location.ZipCode }},
+		{"country", func(b yelp.Business) string { return b.Location.Country }},
+		{"latitude", func(b yelp.Business) string { return yelp.FloatString(b.Coodinates.Latitude) }},
+		{"longitude", func(b yelp.Business) string { return yelp.FloatString(b.Coodinates.Longitude) }},
+		{"categories", func(b yelp.Business) string { return categoryTitles(b.Categories) }},
+		{"category_aliases", func(b yelp.Business) string { return categoryAliases(b.Categories) }},
+		{"transactions", func(b yelp.Business) string { return strings.Join(b.Transactions, ",") }},
+		{"url", func(b yelp.Business) string { return b.URL }},
+		{"image_url", func(b yelp.Business) string { return b.ImageURL }},
+	} {
+		AllColumns[c.Name] = c
+	}
+
+	DefaultColumns, _ = Columns("id", "name", "rating", "review_count", "price", "address", "categories")
+}
+
+// Columns returns the named columns, in order.
+func Columns(names ...string) ([]Column, error) {
+	columns := make([]Column, len(names))
+	for i, name := range names {
+		c, ok := AllColumns[name]
+		if !ok {
+			return nil, fmt.Errorf("Invalid column provided: %s", name)
+		}
+		columns[i] = c
+	}
+	return columns, nil
+}
+
+// categoryTitles returns the titles of the categories as a comma separated list.
+func categoryTitles(categories []yelp.Category) string {
+	titles := make([]string, len(categories))
+	for i, c := range categories {
+		titles[i] = c.Title
+	}
+	return strings.Join(titles, ", ")
+}
+
+// categoryAliases returns the aliases of the categories as a comma separated list.
+func categoryAliases(categories []yelp.Category) string {
+	aliases := make([]string, len(categories))
+	for i, c := range categories {
+		aliases[i] = c.Alias
+	}
+	return strings.Join(aliases, ",")
+}
@@ -0,0 +1,81 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// regionCenterID is the ID of the feature or placemark of a search region center.
+const regionCenterID = "region_center"
+
+// FeatureCollection is a GeoJSON FeatureCollection, as defined by RFC 7946.
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+// Feature is a GeoJSON Feature with a Point geometry.
+type Feature struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id,omitempty"`
+	Geometry   Point                  `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// Point is a GeoJSON Point geometry. Coordinates are [longitude, latitude].
+type Point struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+// GeoJSON returns the businesses as a FeatureCollection of Points. When region
+// is not nil, its center is included as an additional feature.
+func GeoJSON(businesses []yelp.Business, region *yelp.Region) FeatureCollection {
+	fc := FeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]Feature, 0, len(businesses)+1),
+	}
+	for _, b := range businesses {
+		fc.Features = append(fc.Features, Feature{
+			Type:     "Feature",
+			ID:       b.ID,
+			Geometry: newPoint(b.Coodinates),
+			Properties: map[string]interface{}{
+				"name":         b.Name,
+				"rating":       b.Rating,
+				"review_count": b.ReviewCount,
+				"price":        b.Price,
+				"categories":   categoryTitles(b.Categories),
+				"url":          b.URL,
+			},
+		})
+	}
+	if region != nil {
+		fc.Features = append(fc.Features, Feature{
+			Type:     "Feature",
+			ID:       regionCenterID,
+			Geometry: newPoint(region.Center),
+			Properties: map[string]interface{}{
+				"name": "Region center",
+			},
+		})
+	}
+	return fc
+}
+
+// WriteGeoJSON writes the businesses to w as a GeoJSON FeatureCollection.
+func WriteGeoJSON(w io.Writer, businesses []yelp.Business, region *yelp.Region) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(GeoJSON(businesses, region))
+}
+
+// newPoint returns c as a Point.
+func newPoint(c yelp.Coordinates) Point {
+	return Point{
+		Type:        "Point",
+		Coordinates: [2]float64{c.Longitude, c.Latitude},
+	}
+}
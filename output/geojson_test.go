@@ -0,0 +1,35 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+func TestGeoJSON(t *testing.T) {
+	region := &yelp.Region{Center: yelp.Coordinates{Latitude: 3, Longitude: 4}}
+
+	t.Run("Without region", func(t *testing.T) {
+		fc := GeoJSON(testBusinesses, nil)
+		assert(t, fc.Type == "FeatureCollection" && len(fc.Features) == 2, "Expected a FeatureCollection of 2 features: %+v", fc)
+
+		f := fc.Features[0]
+		assert(t, f.ID == "gary-oak-1" && f.Geometry.Type == "Point", "Unexpected feature: %+v", f)
+		assert(t, f.Geometry.Coordinates == [2]float64{-2.25, 1.5}, "Expected coordinates (%v) to be [longitude, latitude]", f.Geometry.Coordinates)
+		assert(t, f.Properties["categories"] == "Labs, Pet Stores", "Unexpected categories: %v", f.Properties["categories"])
+	})
+
+	t.Run("With region", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := Write(&buf, FormatGeoJSON, &yelp.BusinessSearchResults{Businesses: testBusinesses, Region: *region}, nil)
+		assert(t, err == nil, "Expected no error (%v) writing GeoJSON", err)
+
+		var fc FeatureCollection
+		assert(t, json.Unmarshal(buf.Bytes(), &fc) == nil, "Expected valid JSON: %s", buf.String())
+		assert(t, len(fc.Features) == 3, "Expected 3 features, got %d", len(fc.Features))
+		last := fc.Features[2]
+		assert(t, last.ID == regionCenterID && last.Geometry.Coordinates == [2]float64{4, 3}, "Unexpected region feature: %+v", last)
+	})
+}
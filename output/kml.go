@@ -0,0 +1,95 @@
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// kmlNamespace is the XML namespace of KML 2.2 documents.
+const kmlNamespace = "http://www.opengis.net/kml/2.2"
+
+// kml is the root element of a KML document.
+type kml struct {
+	XMLName  xml.Name    `xml:"kml"`
+	XMLNS    string      `xml:"xmlns,attr"`
+	Document kmlDocument `xml:"Document"`
+}
+
+// kmlDocument contains the placemarks of a KML document.
+type kmlDocument struct {
+	Name       string         `xml:"name"`
+	Placemarks []kmlPlacemark `xml:"Placemark"`
+}
+
+// kmlPlacemark is a named point.
+type kmlPlacemark struct {
+	ID           string    `xml:"id,attr,omitempty"`
+	Name         string    `xml:"name"`
+	Description  string    `xml:"description,omitempty"`
+	ExtendedData []kmlData `xml:"ExtendedData>Data,omitempty"`
+	Point        kmlPoint  `xml:"Point"`
+}
+
+// kmlData is a named value of a placemark.
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+// kmlPoint is the location of a placemark as "longitude,latitude".
+type kmlPoint struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+// WriteKML writes the businesses to w as a KML document with a placemark for
+// each business. When region is not nil, its center is included as an
+// additional placemark.
+func WriteKML(w io.Writer, businesses []yelp.Business, region *yelp.Region) error {
+	doc := kml{
+		XMLNS:    kmlNamespace,
+		Document: kmlDocument{Name: "Yelp businesses"},
+	}
+	for _, b := range businesses {
+		doc.Document.Placemarks = append(doc.Document.Placemarks, kmlPlacemark{
+			ID:          b.ID,
+			Name:        b.Name,
+			Description: b.URL,
+			ExtendedData: []kmlData{
+				{Name: "rating", Value: yelp.FloatString(b.Rating)},
+				{Name: "review_count", Value: yelp.IntString(b.ReviewCount)},
+				{Name: "price", Value: b.Price},
+				{Name: "categories", Value: categoryTitles(b.Categories)},
+				{Name: "url", Value: b.URL},
+			},
+			Point: newKMLPoint(b.Coodinates),
+		})
+	}
+	if region != nil {
+		doc.Document.Placemarks = append(doc.Document.Placemarks, kmlPlacemark{
+			ID:    regionCenterID,
+			Name:  "Region center",
+			Point: newKMLPoint(region.Center),
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+// newKMLPoint returns c as a kmlPoint.
+func newKMLPoint(c yelp.Coordinates) kmlPoint {
+	return kmlPoint{
+		Coordinates: fmt.Sprintf("%s,%s", yelp.FloatString(c.Longitude), yelp.FloatString(c.Latitude)),
+	}
+}
@@ -0,0 +1,26 @@
+package output
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+func TestWriteKML(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteKML(&buf, testBusinesses, &yelp.Region{Center: yelp.Coordinates{Latitude: 3, Longitude: 4}})
+	assert(t, err == nil, "Expected no error (%v) writing KML", err)
+	assert(t, strings.HasPrefix(buf.String(), xml.Header), "Expected an XML header: %s", buf.String())
+
+	var doc kml
+	assert(t, xml.Unmarshal(buf.Bytes(), &doc) == nil, "Expected valid XML: %s", buf.String())
+	assert(t, doc.XMLName.Space == kmlNamespace, "Expected the KML namespace, got %s", doc.XMLName.Space)
+	assert(t, len(doc.Document.Placemarks) == 3, "Expected 3 placemarks, got %d", len(doc.Document.Placemarks))
+
+	p := doc.Document.Placemarks[0]
+	assert(t, p.Name == "Gary's \"Lab\", Pallet" && p.Point.Coordinates == "-2.25,1.5", "Unexpected placemark: %+v", p)
+	assert(t, doc.Document.Placemarks[2].ID == regionCenterID, "Expected the region center placemark last")
+}
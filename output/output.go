@@ -0,0 +1,189 @@
+// Package output renders Yelp API results as JSON, newline-delimited JSON,
+// aligned terminal tables, CSV, GeoJSON or KML.
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// Format is an output format.
+type Format string
+
+// The supported output formats.
+const (
+	FormatJSON    Format = "json"
+	FormatJSONL   Format = "jsonl"
+	FormatTable   Format = "table"
+	FormatCSV     Format = "csv"
+	FormatGeoJSON Format = "geojson"
+	FormatKML     Format = "kml"
+)
+
+// ParseFormat returns the Format named by s.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatJSON, FormatJSONL, FormatTable, FormatCSV, FormatGeoJSON, FormatKML:
+		return f, nil
+	default:
+		return "", fmt.Errorf("Invalid format provided: %s", s)
+	}
+}
+
+// Write renders v to w in the given format. Results that are not businesses can
+// only be written as JSON. Businesses are written as one line per business in the
+// JSONL, table and CSV formats, where the table and CSV formats only include
+// the given columns, or DefaultColumns if none are given. The GeoJSON and KML
+// formats include the region center of search results.
+//
+// v may be a *yelp.BusinessSearchResults, *yelp.BusinessMatchResults,
+// []yelp.Business or *yelp.Business. Other values are written as JSON, and
+// return an error for the other formats.
+func Write(w io.Writer, f Format, v interface{}, columns []Column) error {
+	if f == FormatJSON {
+		return WriteJSON(w, v)
+	}
+
+	businesses, ok := businessesOf(v)
+	if !ok {
+		return fmt.Errorf("%s output is not supported for %T", f, v)
+	}
+	if len(columns) == 0 {
+		columns = DefaultColumns
+	}
+
+	switch f {
+	case FormatJSONL:
+		return WriteJSONL(w, businesses)
+	case FormatTable:
+		return WriteTable(w, businesses, columns)
+	case FormatCSV:
+		return WriteCSV(w, businesses, columns)
+	case FormatGeoJSON:
+		return WriteGeoJSON(w, businesses, regionOf(v))
+	case FormatKML:
+		return WriteKML(w, businesses, regionOf(v))
+	default:
+		return fmt.Errorf("Invalid format provided: %s", f)
+	}
+}
+
+// WriteJSON writes v to w as indented JSON.
+func WriteJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// WriteJSONL writes each business to w as a line of JSON.
+func WriteJSONL(w io.Writer, businesses []yelp.Business) error {
+	enc := json.NewEncoder(w)
+	for _, b := range businesses {
+		if err := enc.Encode(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteTable writes the businesses to w as a table with aligned columns.
+func WriteTable(w io.Writer, businesses []yelp.Business, columns []Column) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = strings.ToUpper(c.Name)
+	}
+	if _, err := fmt.Fprintln(tw, strings.Join(header, "\t")); err != nil {
+		return err
+	}
+
+	// tabs and newlines in values would break the alignment of the table
+	sanitize := strings.NewReplacer("\t", " ", "\n", " ", "\r", " ")
+	for _, b := range businesses {
+		row := make([]string, len(columns))
+		for i, c := range columns {
+			row[i] = sanitize.Replace(c.Value(b))
+		}
+		if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+// WriteCSV writes the businesses to w as RFC 4180 CSV with a header row.
+func WriteCSV(w io.Writer, businesses []yelp.Business, columns []Column) error {
+	cw := csv.NewWriter(w)
+	cw.UseCRLF = true
+
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = c.Name
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, b := range businesses {
+		row := make([]string, len(columns))
+		for i, c := range columns {
+			row[i] = c.Value(b)
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// businessesOf returns the businesses contained in v.
+func businessesOf(v interface{}) ([]yelp.Business, bool) {
+	switch v := v.(type) {
+	case *yelp.BusinessSearchResults:
+		if v == nil {
+			return nil, true
+		}
+		return v.Businesses, true
+	case yelp.BusinessSearchResults:
+		return v.Businesses, true
+	case *yelp.BusinessMatchResults:
+		if v == nil {
+			return nil, true
+		}
+		return v.Businesses, true
+	case yelp.BusinessMatchResults:
+		return v.Businesses, true
+	case []yelp.Business:
+		return v, true
+	case *yelp.Business:
+		if v == nil {
+			return nil, true
+		}
+		return []yelp.Business{*v}, true
+	case yelp.Business:
+		return []yelp.Business{v}, true
+	default:
+		return nil, false
+	}
+}
+
+// regionOf returns the region of v if it is a set of search results.
+func regionOf(v interface{}) *yelp.Region {
+	switch v := v.(type) {
+	case *yelp.BusinessSearchResults:
+		if v == nil {
+			return nil
+		}
+		return &v.Region
+	case yelp.BusinessSearchResults:
+		return &v.Region
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,113 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+var testBusinesses = []yelp.Business{
+	{
+		ID:          "gary-oak-1",
+		Name:        "Gary's \"Lab\", Pallet",
+		Rating:      4.5,
+		ReviewCount: 151,
+		Price:       "$$",
+		Location: yelp.Location{
+			DisplayAddress: []string{"1 Route 1", "Pallet Town"},
+			City:           "Pallet Town",
+		},
+		Coodinates: yelp.Coordinates{Latitude: 1.5, Longitude: -2.25},
+		Categories: []yelp.Category{
+			{Alias: "labs", Title: "Labs"},
+			{Alias: "pets", Title: "Pet Stores"},
+		},
+	},
+	{
+		ID:   "misty-2",
+		Name: "Cerulean\tGym",
+	},
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := ParseFormat("CSV")
+	assert(t, err == nil && f == FormatCSV, "Expected CSV format, got %s (%v)", f, err)
+
+	_, err = ParseFormat("xml")
+	assert(t, err != nil, "Invalid format should error")
+}
+
+func TestColumns(t *testing.T) {
+	columns, err := Columns("name", "latitude", "category_aliases")
+	assert(t, err == nil && len(columns) == 3, "Expected 3 columns (%v)", err)
+	assert(t, columns[1].Value(testBusinesses[0]) == "1.5", "Latitude: Expected %s to equal 1.5", columns[1].Value(testBusinesses[0]))
+	assert(t, columns[2].Value(testBusinesses[0]) == "labs,pets", "Category aliases: Expected %s to equal labs,pets", columns[2].Value(testBusinesses[0]))
+
+	_, err = Columns("name", "favorite_pokemon")
+	assert(t, err != nil, "Invalid column should error")
+}
+
+func TestWrite(t *testing.T) {
+	results := &yelp.BusinessSearchResults{Total: 2, Businesses: testBusinesses}
+	columns, _ := Columns("id", "name", "address", "categories")
+
+	t.Run("JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert(t, Write(&buf, FormatJSON, results, columns) == nil, "Expected no error writing JSON")
+
+		var decoded yelp.BusinessSearchResults
+		assert(t, json.Unmarshal(buf.Bytes(), &decoded) == nil && decoded.Total == 2, "Expected the search results to be written")
+	})
+
+	t.Run("JSONL", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert(t, Write(&buf, FormatJSONL, results, columns) == nil, "Expected no error writing JSONL")
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert(t, len(lines) == 2, "Expected 2 lines, got %d", len(lines))
+		var b yelp.Business
+		assert(t, json.Unmarshal([]byte(lines[1]), &b) == nil && b.ID == "misty-2", "Expected the second line to be misty-2: %s", lines[1])
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert(t, Write(&buf, FormatCSV, testBusinesses, columns) == nil, "Expected no error writing CSV")
+
+		expected := "id,name,address,categories\r\n" +
+			"gary-oak-1,\"Gary's \"\"Lab\"\", Pallet\",\"1 Route 1, Pallet Town\",\"Labs, Pet Stores\"\r\n" +
+			"misty-2,Cerulean\tGym,,\r\n"
+		assert(t, buf.String() == expected, "Expected CSV:\n%q\nto equal:\n%q", buf.String(), expected)
+	})
+
+	t.Run("Table", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert(t, Write(&buf, FormatTable, &testBusinesses[1], nil) == nil, "Expected no error writing a table")
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert(t, len(lines) == 2, "Expected a header and 1 row, got %d lines", len(lines))
+		assert(t, strings.HasPrefix(lines[0], "ID ") && strings.Contains(lines[0], "REVIEW_COUNT"), "Expected the default columns in the header: %s", lines[0])
+		assert(t, strings.Index(lines[0], "NAME") == strings.Index(lines[1], "Cerulean Gym"), "Expected the columns to be aligned:\n%s", buf.String())
+	})
+
+	t.Run("Not businesses", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert(t, Write(&buf, FormatJSON, map[string]int{"total": 1}, nil) == nil, "Expected no error writing other values as JSON")
+		assert(t, strings.Contains(buf.String(), "\"total\": 1"), "Expected other values to be written as JSON: %s", buf.String())
+
+		for _, f := range []Format{FormatJSONL, FormatTable, FormatCSV, FormatGeoJSON, FormatKML} {
+			buf.Reset()
+			err := Write(&buf, f, &yelp.ReviewsResults{Total: 1}, nil)
+			assert(t, err != nil && err.Error() == string(f)+" output is not supported for *yelp.ReviewsResults", "Expected an error writing other values as %s, got %v", f, err)
+			assert(t, buf.Len() == 0, "Expected nothing to be written as %s: %s", f, buf.String())
+		}
+	})
+}
+
+func assert(t *testing.T, condition bool, assertionFormat string, values ...interface{}) {
+	if !condition {
+		t.Fatalf(assertionFormat, values...)
+	}
+}
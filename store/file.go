@@ -0,0 +1,290 @@
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alex-chou/go-yelp/internal/atomicfile"
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// SchemaVersion is the version of the files written by FileStore. It is
+// increased when a change to the stored types cannot be read by the previous
+// version, along with a migration in migrations.
+const SchemaVersion = 1
+
+// migrations upgrade the data of a file from the schema version they are keyed
+// by to the next version.
+var migrations = map[int]func(json.RawMessage) (json.RawMessage, error){}
+
+// Directories of a FileStore.
+const (
+	businessesDir = "businesses"
+	searchesDir   = "searches"
+)
+
+// maxFileNameLength is the longest escaped key used as a file name, leaving room
+// for the extension and temporary file suffix within common file system limits.
+const maxFileNameLength = 200
+
+// hashedPrefix is the prefix of file names of keys that are too long to use.
+const hashedPrefix = "sha256-"
+
+// envelope is the format of the files written by FileStore.
+type envelope struct {
+	SchemaVersion int             `json:"schema_version"`
+	Key           string          `json:"key"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// FileStore is a Store which saves each business and search as a JSON file in a
+// directory. Files are written atomically, and are versioned so that they can
+// be read after the stored types change. It is safe for concurrent use within a
+// process.
+type FileStore struct {
+	mu  sync.RWMutex
+	dir string
+
+	// now returns the current time.
+	now func() time.Time
+}
+
+// FileStore implements the Store interface.
+var _ Store = (*FileStore)(nil)
+
+// NewFileStore returns a FileStore in dir, creating it if it does not exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	for _, sub := range []string{businessesDir, searchesDir} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0755); err != nil {
+			return nil, err
+		}
+	}
+	return &FileStore{dir: dir, now: time.Now}, nil
+}
+
+// Get implements the Store interface.
+func (fs *FileStore) Get(ctx context.Context, id string) (*yelp.Business, error) {
+	var b yelp.Business
+	if _, ok, err := fs.read(businessesDir, id, &b); err != nil || !ok {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// Put implements the Store interface.
+func (fs *FileStore) Put(ctx context.Context, b yelp.Business) error {
+	if b.ID == "" {
+		return fmt.Errorf("business ID is not set")
+	}
+	return fs.write(businessesDir, b.ID, b)
+}
+
+// Delete implements the Store interface.
+func (fs *FileStore) Delete(ctx context.Context, id string) error {
+	return fs.remove(businessesDir, id)
+}
+
+// List implements the Store interface.
+func (fs *FileStore) List(ctx context.Context) ([]string, error) {
+	return fs.list(businessesDir)
+}
+
+// UpdatedSince implements the Store interface. Every saved business is read, so
+// it takes time proportional to the size of the store.
+func (fs *FileStore) UpdatedSince(ctx context.Context, since time.Time) ([]Record, error) {
+	ids, err := fs.list(businessesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []Record
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var r Record
+		updatedAt, ok, err := fs.read(businessesDir, id, &r.Business)
+		if err != nil {
+			return nil, err
+		}
+		if ok && !updatedAt.Before(since) {
+			r.UpdatedAt = updatedAt
+			records = append(records, r)
+		}
+	}
+	return records, nil
+}
+
+// GetSearch implements the Store interface.
+func (fs *FileStore) GetSearch(ctx context.Context, key string) (*SearchSnapshot, error) {
+	ss := &SearchSnapshot{Key: key}
+	updatedAt, ok, err := fs.read(searchesDir, key, &ss.Results)
+	if err != nil || !ok {
+		return nil, err
+	}
+	ss.UpdatedAt = updatedAt
+	return ss, nil
+}
+
+// PutSearch implements the Store interface.
+func (fs *FileStore) PutSearch(ctx context.Context, key string, results yelp.BusinessSearchResults) error {
+	return fs.write(searchesDir, key, results)
+}
+
+// DeleteSearch implements the Store interface.
+func (fs *FileStore) DeleteSearch(ctx context.Context, key string) error {
+	return fs.remove(searchesDir, key)
+}
+
+// ListSearches implements the Store interface.
+func (fs *FileStore) ListSearches(ctx context.Context) ([]string, error) {
+	return fs.list(searchesDir)
+}
+
+// read decodes the data of the file for key in sub into v, migrating it to the
+// current schema version. It returns when the file was written and whether it
+// exists.
+func (fs *FileStore) read(sub, key string, v interface{}) (time.Time, bool, error) {
+	fs.mu.RLock()
+	b, err := ioutil.ReadFile(fs.path(sub, key))
+	fs.mu.RUnlock()
+	if os.IsNotExist(err) {
+		return time.Time{}, false, nil
+	} else if err != nil {
+		return time.Time{}, false, err
+	}
+
+	var env envelope
+	if err := json.Unmarshal(b, &env); err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid file for %s: %v", key, err)
+	}
+	if env.SchemaVersion > SchemaVersion {
+		return time.Time{}, false, fmt.Errorf("file for %s has schema version %d, newer than %d", key, env.SchemaVersion, SchemaVersion)
+	}
+	for version := env.SchemaVersion; version < SchemaVersion; version++ {
+		migrate, ok := migrations[version]
+		if !ok {
+			return time.Time{}, false, fmt.Errorf("no migration from schema version %d for %s", version, key)
+		}
+		if env.Data, err = migrate(env.Data); err != nil {
+			return time.Time{}, false, fmt.Errorf("migrating %s from schema version %d: %v", key, version, err)
+		}
+	}
+	if err := json.Unmarshal(env.Data, v); err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid file for %s: %v", key, err)
+	}
+	return env.UpdatedAt, true, nil
+}
+
+// write atomically writes v to the file for key in sub.
+func (fs *FileStore) write(sub, key string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(envelope{
+		SchemaVersion: SchemaVersion,
+		Key:           key,
+		UpdatedAt:     fs.now().UTC(),
+		Data:          data,
+	})
+	if err != nil {
+		return err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return atomicfile.WriteFile(fs.path(sub, key), b, 0644)
+}
+
+// remove deletes the file for key in sub, if it exists.
+func (fs *FileStore) remove(sub, key string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if err := os.Remove(fs.path(sub, key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// list returns the keys of the files in sub, sorted.
+func (fs *FileStore) list(sub string) ([]string, error) {
+	fs.mu.RLock()
+	infos, err := ioutil.ReadDir(filepath.Join(fs.dir, sub))
+	fs.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		name = strings.TrimSuffix(name, ".json")
+		if strings.HasPrefix(name, hashedPrefix) {
+			// the key of a hashed file name is only stored in the file
+			b, err := ioutil.ReadFile(filepath.Join(fs.dir, sub, info.Name()))
+			if err != nil {
+				return nil, err
+			}
+			var env envelope
+			if err := json.Unmarshal(b, &env); err != nil {
+				return nil, fmt.Errorf("invalid file %s: %v", info.Name(), err)
+			}
+			keys = append(keys, env.Key)
+			continue
+		}
+		if key, err := url.PathUnescape(name); err == nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// path returns the path of the file for key in sub. Keys are escaped so that
+// they are always a single valid file name, and long keys are hashed.
+func (fs *FileStore) path(sub, key string) string {
+	name := escapeKey(key)
+	if strings.HasPrefix(name, ".") {
+		name = "%2E" + name[1:]
+	}
+	if len(name) > maxFileNameLength || strings.HasPrefix(name, hashedPrefix) {
+		name = fmt.Sprintf("%s%x", hashedPrefix, sha256.Sum256([]byte(key)))
+	}
+	return filepath.Join(fs.dir, sub, name+".json")
+}
+
+// escapeKey path escapes key, and also escapes its upper case letters so that
+// keys which only differ in case, like Yelp business IDs can, do not share a
+// file on case-insensitive file systems. Escapes always use upper case hex
+// digits, so no two escaped keys only differ in case.
+func escapeKey(key string) string {
+	escaped := url.PathEscape(key)
+	var b strings.Builder
+	for i := 0; i < len(escaped); i++ {
+		switch c := escaped[i]; {
+		case c == '%' && i+2 < len(escaped):
+			b.WriteString(escaped[i : i+3])
+			i += 2
+		case 'A' <= c && c <= 'Z':
+			fmt.Fprintf(&b, "%%%02X", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
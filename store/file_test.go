@@ -0,0 +1,125 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alex-chou/go-yelp/monitor"
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// FileStore can be used as a monitor.SnapshotStore.
+var _ monitor.SnapshotStore = (*FileStore)(nil)
+
+func newTestFileStore(t *testing.T) (*FileStore, func()) {
+	dir, err := ioutil.TempDir("", "store")
+	assert(t, err == nil, "Expected no error (%v) creating a temp dir", err)
+	fs, err := NewFileStore(dir)
+	assert(t, err == nil, "Expected no error (%v) creating a FileStore", err)
+	return fs, func() { os.RemoveAll(dir) }
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	fs, cleanup := newTestFileStore(t)
+	defer cleanup()
+
+	now := time.Date(2019, time.November, 25, 12, 0, 0, 0, time.UTC)
+	fs.now = func() time.Time { return now }
+
+	t.Run("Businesses", func(t *testing.T) {
+		b, err := fs.Get(ctx, "mew")
+		assert(t, err == nil && b == nil, "Expected no business before it is saved (%v)", err)
+
+		mew := yelp.Business{ID: "mew", Name: "Mew", Rating: 5, Alias: yelp.StringPointer("mew-faraway-island")}
+		assert(t, fs.Put(ctx, mew) == nil, "Expected no error saving a business")
+		now = now.Add(time.Hour)
+		for _, id := range []string{"mewtwo", "../etc/passwd", ".hidden"} {
+			assert(t, fs.Put(ctx, yelp.Business{ID: id}) == nil, "Expected no error saving %s", id)
+		}
+
+		b, err = fs.Get(ctx, "mew")
+		assert(t, err == nil && reflect.DeepEqual(*b, mew), "Expected the saved business (%v): %+v", err, b)
+
+		ids, err := fs.List(ctx)
+		assert(t, err == nil && reflect.DeepEqual(ids, []string{"../etc/passwd", ".hidden", "mew", "mewtwo"}), "Unexpected IDs (%v): %v", err, ids)
+		_, err = os.Stat(filepath.Join(fs.dir, "etc"))
+		assert(t, os.IsNotExist(err), "Expected IDs to not escape the store directory")
+
+		records, err := fs.UpdatedSince(ctx, now)
+		assert(t, err == nil && len(records) == 3, "Expected 3 recently updated businesses (%v): %+v", err, records)
+		assert(t, records[0].UpdatedAt.Equal(now), "Expected the update time to be set: %v", records[0].UpdatedAt)
+
+		assert(t, fs.Delete(ctx, "mewtwo") == nil && fs.Delete(ctx, "mewtwo") == nil, "Expected no error deleting a business twice")
+		b, _ = fs.Get(ctx, "mewtwo")
+		assert(t, b == nil, "Expected the deleted business to be gone")
+
+		assert(t, fs.Put(ctx, yelp.Business{}) != nil, "Expected an error saving a business without an ID")
+	})
+
+	t.Run("IDs differing in case", func(t *testing.T) {
+		ids := []string{"PoRyGoN", "porygon", "PORYGON"}
+		for _, id := range ids {
+			assert(t, fs.Put(ctx, yelp.Business{ID: id, Name: id}) == nil, "Expected no error saving %s", id)
+		}
+		for i, id := range ids {
+			b, err := fs.Get(ctx, id)
+			assert(t, err == nil && b != nil && b.Name == id, "Expected %s to not be overwritten (%v): %+v", id, err, b)
+			for _, other := range ids[i+1:] {
+				name, otherName := filepath.Base(fs.path(businessesDir, id)), filepath.Base(fs.path(businessesDir, other))
+				assert(t, !strings.EqualFold(name, otherName), "Expected the file names of %s and %s to differ ignoring case: %s, %s", id, other, name, otherName)
+			}
+		}
+		keys, err := fs.List(ctx)
+		assert(t, err == nil && strings.Contains(strings.Join(keys, ","), "PORYGON,PoRyGoN"), "Expected the IDs to be listed (%v): %v", err, keys)
+		for _, id := range ids {
+			fs.Delete(ctx, id)
+		}
+	})
+
+	t.Run("Searches", func(t *testing.T) {
+		key := "location=Kanto&term=" + strings.Repeat("pokemon+", 50)
+		results := yelp.BusinessSearchResults{Total: 1, Businesses: []yelp.Business{{ID: "mew"}}}
+		assert(t, fs.PutSearch(ctx, key, results) == nil, "Expected no error saving a search")
+		assert(t, fs.PutSearch(ctx, "location=Johto", results) == nil, "Expected no error saving a search")
+
+		ss, err := fs.GetSearch(ctx, key)
+		assert(t, err == nil && ss != nil && reflect.DeepEqual(ss.Results, results) && ss.Key == key, "Expected the saved search (%v): %+v", err, ss)
+
+		keys, err := fs.ListSearches(ctx)
+		assert(t, err == nil && reflect.DeepEqual(keys, []string{"location=Johto", key}), "Unexpected keys (%v): %v", err, keys)
+
+		assert(t, fs.DeleteSearch(ctx, key) == nil, "Expected no error deleting a search")
+		ss, err = fs.GetSearch(ctx, key)
+		assert(t, err == nil && ss == nil, "Expected the deleted search to be gone (%v)", err)
+	})
+
+	t.Run("Schema versions", func(t *testing.T) {
+		newer := `{"schema_version":2,"key":"celebi","updated_at":"2019-11-25T12:00:00Z","data":{}}`
+		assert(t, ioutil.WriteFile(fs.path(businessesDir, "celebi"), []byte(newer), 0644) == nil, "Expected no error writing a file")
+		_, err := fs.Get(ctx, "celebi")
+		assert(t, err != nil, "Expected an error reading a newer schema version")
+
+		older := `{"schema_version":0,"key":"jirachi","updated_at":"2019-11-25T12:00:00Z","data":{"title":"Jirachi"}}`
+		assert(t, ioutil.WriteFile(fs.path(businessesDir, "jirachi"), []byte(older), 0644) == nil, "Expected no error writing a file")
+		migrations[0] = func(data json.RawMessage) (json.RawMessage, error) {
+			return json.RawMessage(strings.Replace(string(data), "title", "name", 1)), nil
+		}
+		defer delete(migrations, 0)
+		b, err := fs.Get(ctx, "jirachi")
+		assert(t, err == nil && b.Name == "Jirachi", "Expected older schema versions to be migrated (%v): %+v", err, b)
+	})
+}
+
+func assert(t *testing.T, condition bool, assertionFormat string, values ...interface{}) {
+	if !condition {
+		t.Fatalf(assertionFormat, values...)
+	}
+}
@@ -0,0 +1,49 @@
+// Package store persists businesses and search results fetched from the Yelp
+// API.
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/alex-chou/go-yelp/yelp"
+)
+
+// Store saves and loads businesses and search result snapshots. A Store can be
+// used as the SnapshotStore of a monitor.Monitor.
+type Store interface {
+	// Get returns the saved business with the given ID, or nil if there is none.
+	Get(ctx context.Context, id string) (*yelp.Business, error)
+	// Put saves b, replacing any business with the same ID.
+	Put(ctx context.Context, b yelp.Business) error
+	// Delete removes the business with the given ID, if it is saved.
+	Delete(ctx context.Context, id string) error
+	// List returns the IDs of every saved business.
+	List(ctx context.Context) ([]string, error)
+	// UpdatedSince returns the businesses saved at or after since.
+	UpdatedSince(ctx context.Context, since time.Time) ([]Record, error)
+
+	// GetSearch returns the search results saved with the given key, or nil if
+	// there are none.
+	GetSearch(ctx context.Context, key string) (*SearchSnapshot, error)
+	// PutSearch saves the search results with the given key, ie. the encoded
+	// URL values of the search options.
+	PutSearch(ctx context.Context, key string, results yelp.BusinessSearchResults) error
+	// DeleteSearch removes the search results saved with the given key, if any.
+	DeleteSearch(ctx context.Context, key string) error
+	// ListSearches returns the keys of every saved search.
+	ListSearches(ctx context.Context) ([]string, error)
+}
+
+// Record is a saved business and when it was saved.
+type Record struct {
+	Business  yelp.Business `json:"business"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// SearchSnapshot is saved search results and when they were saved.
+type SearchSnapshot struct {
+	Key       string                     `json:"key"`
+	Results   yelp.BusinessSearchResults `json:"results"`
+	UpdatedAt time.Time                  `json:"updated_at"`
+}
@@ -0,0 +1,92 @@
+package yelp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// AutocompleteOptions contains the available parameters for the Autocomplete
+// API. Businesses are only suggested when Coordinates are set.
+type AutocompleteOptions struct {
+	Text        string
+	Coordinates *Coordinates
+	Locale      *string
+}
+
+// AutocompleteResults reflects the JSON returned by the Autocomplete API.
+type AutocompleteResults struct {
+	Terms      []AutocompleteTerm     `json:"terms"`
+	Businesses []AutocompleteBusiness `json:"businesses"`
+	Categories []Category             `json:"categories"`
+}
+
+// AutocompleteTerm is a suggested search term.
+type AutocompleteTerm struct {
+	Text string `json:"text"`
+}
+
+// AutocompleteBusiness is a suggested business.
+type AutocompleteBusiness struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Autocomplete makes a request given the options provided.
+func (c *client) Autocomplete(ctx context.Context, ao *AutocompleteOptions) (*AutocompleteResults, error) {
+	if err := ao.Validate(); err != nil {
+		return nil, err
+	}
+	var respBody AutocompleteResults
+	_, err := c.authedDo(ctx, http.MethodGet, autocompletePath(ao), nil, nil, &respBody)
+	return &respBody, err
+}
+
+// autocompletePath returns the autocomplete path with parameters.
+func autocompletePath(ao *AutocompleteOptions) string {
+	return fmt.Sprintf("/v3/autocomplete?%s", ao.URLValues().Encode())
+}
+
+// Validate returns an error with details when AutocompleteOptions are not
+// valid. Every invalid field is reported in the returned *ValidationError.
+func (ao *AutocompleteOptions) Validate() error {
+	if ao == nil {
+		return errors.New("AutocompleteOptions are unset")
+	}
+
+	ve := &ValidationError{Options: "AutocompleteOptions"}
+	if ao.Text == "" {
+		ve.add("Text", "is not set")
+	}
+	if ao.Coordinates != nil {
+		if lat := ao.Coordinates.Latitude; lat < -90 || lat > 90 {
+			ve.add("Coordinates.Latitude", "must be between -90 and 90: %s", FloatString(lat))
+		}
+		if lng := ao.Coordinates.Longitude; lng < -180 || lng > 180 {
+			ve.add("Coordinates.Longitude", "must be between -180 and 180: %s", FloatString(lng))
+		}
+	}
+	if ao.Locale != nil && ValidateLocale(*ao.Locale) != nil {
+		ve.add("Locale", "is not a supported locale: %s", *ao.Locale)
+	}
+	return ve.errorOrNil()
+}
+
+// URLValues returns AutocompleteOptions as url.Values.
+func (ao *AutocompleteOptions) URLValues() url.Values {
+	if ao == nil {
+		return nil
+	}
+
+	vals := url.Values{}
+	if ao.Coordinates != nil {
+		vals = ao.Coordinates.URLValues()
+	}
+	vals.Add("text", ao.Text)
+	if ao.Locale != nil {
+		vals.Add("locale", *ao.Locale)
+	}
+	return vals
+}
@@ -0,0 +1,45 @@
+package yelp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAutocomplete(t *testing.T) {
+	ctx := context.Background()
+	mocks := &testMocks{}
+	options := &AutocompleteOptions{}
+	t.Run("invalid options", func(t *testing.T) {
+		client := newTestClient(nil, "API_KEY", mocks)
+		_, err := client.Autocomplete(ctx, options)
+		assert(t, err != nil, "Expected an error when options are invalid")
+	})
+
+	t.Run("failed request", func(t *testing.T) {
+		options.Text = "poke"
+		mocks.mockRequest(http.MethodGet, autocompletePath(options), http.StatusInternalServerError, errors.New("Internal server error"))
+		client := newTestClient(mocks.server.Client(), "API_KEY", mocks)
+
+		_, err := client.Autocomplete(ctx, options)
+		assert(t, err != nil, "Expected an error when request fails")
+	})
+
+	t.Run("successful request", func(t *testing.T) {
+		expected := AutocompleteResults{
+			Terms:      []AutocompleteTerm{{Text: "Poke Bowl"}},
+			Businesses: []AutocompleteBusiness{{ID: "pokemon-center", Name: "Pokemon Center"}},
+			Categories: []Category{{Alias: "poke", Title: "Poke"}},
+		}
+		options.Text = "pok"
+		options.Coordinates = &Coordinates{Latitude: 37.77, Longitude: -122.41}
+		mocks.mockRequest(http.MethodGet, autocompletePath(options), http.StatusOK, expected)
+		client := newTestClient(mocks.server.Client(), "API_KEY", mocks)
+
+		results, err := client.Autocomplete(ctx, options)
+		assert(t, err == nil, "Expected no error (%v) when request succeeds", err)
+		assert(t, reflect.DeepEqual(*results, expected), "Results (%v) did not match expected (%v)", results, expected)
+	})
+}
@@ -0,0 +1,118 @@
+package yelp
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultBatchWorkers is the number of concurrent requests made by a batch when
+// no worker count is given.
+const defaultBatchWorkers = 4
+
+// BatchResult is the result of a single Get Business request made in a batch.
+type BatchResult struct {
+	// Index is the position of the request in the batch input.
+	Index    int
+	Options  *GetBusinessOptions
+	Business *Business
+	Err      error
+}
+
+// BusinessIDs returns GetBusinessOptions for each of the business IDs.
+func BusinessIDs(ids ...string) []*GetBusinessOptions {
+	options := make([]*GetBusinessOptions, len(ids))
+	for i, id := range ids {
+		options[i] = &GetBusinessOptions{ID: id}
+	}
+	return options
+}
+
+// BatchGetBusiness makes a Get Business request for each of the options using
+// up to workers concurrent requests, and returns the results in input order.
+// Failed requests do not stop the batch; their errors are set on their results.
+// When ctx is done, requests that were not made fail with ctx's error.
+func BatchGetBusiness(ctx context.Context, c Client, options []*GetBusinessOptions, workers int) []BatchResult {
+	in := make(chan *GetBusinessOptions)
+	go func() {
+		defer close(in)
+		for _, gbo := range options {
+			select {
+			case in <- gbo:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	results := make([]BatchResult, len(options))
+	completed := make([]bool, len(options))
+	for r := range StreamGetBusiness(ctx, c, in, workers) {
+		results[r.Index] = r
+		completed[r.Index] = true
+	}
+	for i, gbo := range options {
+		if !completed[i] {
+			results[i] = BatchResult{Index: i, Options: gbo, Err: ctx.Err()}
+		}
+	}
+	return results
+}
+
+// StreamGetBusiness makes a Get Business request for each of the options read
+// from in using up to workers concurrent requests, and sends the results as they
+// complete. The results channel is closed once in is closed and every request
+// has completed, or once ctx is done.
+func StreamGetBusiness(ctx context.Context, c Client, in <-chan *GetBusinessOptions, workers int) <-chan BatchResult {
+	if workers <= 0 {
+		workers = defaultBatchWorkers
+	}
+
+	type job struct {
+		index   int
+		options *GetBusinessOptions
+	}
+	jobs := make(chan job)
+	go func() {
+		defer close(jobs)
+		for i := 0; ; i++ {
+			select {
+			case gbo, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case jobs <- job{index: i, options: gbo}:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	out := make(chan BatchResult)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				business, err := c.GetBusiness(ctx, j.options)
+				if err != nil {
+					business = nil
+				}
+				select {
+				case out <- BatchResult{Index: j.index, Options: j.options, Business: business, Err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
@@ -0,0 +1,103 @@
+package yelp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeClient is a Client which answers requests with the provided functions.
+type fakeClient struct {
+	businessSearch func(context.Context, *BusinessSearchOptions) (*BusinessSearchResults, error)
+	getBusiness    func(context.Context, *GetBusinessOptions) (*Business, error)
+}
+
+func (f *fakeClient) BusinessSearch(ctx context.Context, bso *BusinessSearchOptions) (*BusinessSearchResults, error) {
+	return f.businessSearch(ctx, bso)
+}
+
+func (f *fakeClient) GetBusiness(ctx context.Context, gbo *GetBusinessOptions) (*Business, error) {
+	return f.getBusiness(ctx, gbo)
+}
+
+func TestBatchGetBusiness(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Results are in input order with per item errors", func(t *testing.T) {
+		var inFlight, maxInFlight int64
+		client := &fakeClient{getBusiness: func(ctx context.Context, gbo *GetBusinessOptions) (*Business, error) {
+			n := atomic.AddInt64(&inFlight, 1)
+			defer atomic.AddInt64(&inFlight, -1)
+			for {
+				max := atomic.LoadInt64(&maxInFlight)
+				if n <= max || atomic.CompareAndSwapInt64(&maxInFlight, max, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			if gbo.ID == "missingno" {
+				return nil, errors.New("404 Not Found")
+			}
+			return &Business{ID: gbo.ID}, nil
+		}}
+
+		var ids []string
+		for i := 0; i < 50; i++ {
+			ids = append(ids, fmt.Sprintf("pokemon-%d", i))
+		}
+		ids[17] = "missingno"
+
+		results := BatchGetBusiness(ctx, client, BusinessIDs(ids...), 3)
+		assert(t, len(results) == len(ids), "Expected %d results, got %d", len(ids), len(results))
+		for i, r := range results {
+			assert(t, r.Index == i && r.Options.ID == ids[i], "Expected result %d to be for %s: %+v", i, ids[i], r)
+			if i == 17 {
+				assert(t, r.Err != nil && r.Business == nil, "Expected an error for missingno: %+v", r)
+				continue
+			}
+			assert(t, r.Err == nil && r.Business.ID == ids[i], "Expected business %s: %+v", ids[i], r)
+		}
+		assert(t, maxInFlight <= 3, "Expected at most 3 concurrent requests, got %d", maxInFlight)
+	})
+
+	t.Run("Cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		var once sync.Once
+		client := &fakeClient{getBusiness: func(ctx context.Context, gbo *GetBusinessOptions) (*Business, error) {
+			once.Do(cancel)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}}
+
+		results := BatchGetBusiness(ctx, client, BusinessIDs("a", "b", "c", "d", "e"), 1)
+		assert(t, len(results) == 5, "Expected 5 results, got %d", len(results))
+		for _, r := range results {
+			assert(t, r.Err == context.Canceled, "Expected canceled results: %+v", r)
+		}
+	})
+}
+
+func TestStreamGetBusiness(t *testing.T) {
+	client := &fakeClient{getBusiness: func(ctx context.Context, gbo *GetBusinessOptions) (*Business, error) {
+		return &Business{ID: gbo.ID}, nil
+	}}
+
+	in := make(chan *GetBusinessOptions)
+	go func() {
+		defer close(in)
+		for _, gbo := range BusinessIDs("bulbasaur", "charmander", "squirtle") {
+			in <- gbo
+		}
+	}()
+
+	seen := map[string]int{}
+	for r := range StreamGetBusiness(context.Background(), client, in, 0) {
+		assert(t, r.Err == nil, "Expected no error: %v", r.Err)
+		seen[r.Business.ID] = r.Index
+	}
+	assert(t, len(seen) == 3 && seen["squirtle"] == 2, "Expected 3 indexed results: %v", seen)
+}
@@ -33,10 +33,11 @@ type Business struct {
 	Transactions []string    `json:"transactions"`
 
 	// The fields below are only returned by the Get Business API.
-	Hours     []Hours  `json:"hours,omitempty"`
-	Alias     *string  `json:"alias,omitempty"`
-	IsClaimed *bool    `json:"is_claimed,omitempty"`
-	Photos    []string `json:"photos,omitempty"`
+	Hours        []Hours        `json:"hours,omitempty"`
+	SpecialHours []SpecialHours `json:"special_hours,omitempty"`
+	Alias        *string        `json:"alias,omitempty"`
+	IsClaimed    *bool          `json:"is_claimed,omitempty"`
+	Photos       []string       `json:"photos,omitempty"`
 
 	// The fields below are only available for Yelp Fusion VIP clients.
 	Attributes map[string]interface{} `json:"attributes,omitempty"`
@@ -63,11 +64,24 @@ type Open struct {
 	IsOvernight bool   `json:"is_overnight"`
 }
 
+// SpecialHours are a business's hours on a specific date, ie. a holiday closure,
+// which take precedence over its regular Hours.
+type SpecialHours struct {
+	Date        string `json:"date"`
+	Start       string `json:"start"`
+	End         string `json:"end"`
+	IsClosed    bool   `json:"is_closed"`
+	IsOvernight bool   `json:"is_overnight"`
+}
+
 // GetBusiness makes a request given the options provided.
 func (c *client) GetBusiness(ctx context.Context, gbo *GetBusinessOptions) (*Business, error) {
 	if err := gbo.Validate(); err != nil {
 		return nil, err
 	}
+	if c.batcher != nil {
+		return c.batcher.getBusiness(ctx, gbo)
+	}
 	var respBody Business
 	_, err := c.authedDo(ctx, http.MethodGet, getBusinessPath(gbo), nil, nil, &respBody)
 	return &respBody, err
@@ -0,0 +1,175 @@
+package yelp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Limits on the Business Match API parameters, as documented by Yelp.
+const (
+	// maxMatchFieldLength is the longest allowed name, address line and city.
+	maxMatchFieldLength = 64
+	// maxMatchStateLength is the longest allowed state code.
+	maxMatchStateLength = 3
+	// maxMatchLimit is the largest number of businesses returned.
+	maxMatchLimit = 10
+)
+
+// MatchThreshold is how closely the Business Match API requires businesses to
+// match.
+type MatchThreshold string
+
+// The match thresholds supported by the Business Match API.
+const (
+	MatchThresholdNone    MatchThreshold = "none"
+	MatchThresholdDefault MatchThreshold = "default"
+	MatchThresholdStrict  MatchThreshold = "strict"
+)
+
+// MatchThresholdPointer returns a pointer to the input.
+func MatchThresholdPointer(m MatchThreshold) *MatchThreshold {
+	return &m
+}
+
+// Validate returns an error if m is not a supported match threshold.
+func (m MatchThreshold) Validate() error {
+	switch m {
+	case MatchThresholdNone, MatchThresholdDefault, MatchThresholdStrict:
+		return nil
+	default:
+		return fmt.Errorf("Invalid match threshold provided: %s", m)
+	}
+}
+
+// BusinessMatchOptions contains the available parameters for the Business Match
+// API. Name, Address1, City, State and Country are required.
+type BusinessMatchOptions struct {
+	Name           string
+	Address1       string
+	Address2       *string
+	Address3       *string
+	City           string
+	State          string
+	Country        string
+	ZipCode        *string
+	Coordinates    *Coordinates
+	Phone          *string
+	YelpBusinessID *string
+	Limit          *int64
+	MatchThreshold *MatchThreshold
+}
+
+// BusinessMatchResults reflects the JSON returned by the Business Match API.
+// Only the ID, alias, name, location, coordinates and phone of the businesses
+// are set.
+type BusinessMatchResults struct {
+	Businesses []Business `json:"businesses"`
+}
+
+// BusinessMatch makes a request given the options provided.
+func (c *client) BusinessMatch(ctx context.Context, bmo *BusinessMatchOptions) (*BusinessMatchResults, error) {
+	if err := bmo.Validate(); err != nil {
+		return nil, err
+	}
+	var respBody BusinessMatchResults
+	_, err := c.authedDo(ctx, http.MethodGet, businessMatchPath(bmo), nil, nil, &respBody)
+	return &respBody, err
+}
+
+// businessMatchPath returns the business match path with parameters.
+func businessMatchPath(bmo *BusinessMatchOptions) string {
+	return fmt.Sprintf("/v3/businesses/matches?%s", bmo.URLValues().Encode())
+}
+
+// Validate returns an error with details when BusinessMatchOptions are not
+// valid. Every invalid field is reported in the returned *ValidationError.
+func (bmo *BusinessMatchOptions) Validate() error {
+	if bmo == nil {
+		return errors.New("BusinessMatchOptions are unset")
+	}
+
+	ve := &ValidationError{Options: "BusinessMatchOptions"}
+	for _, field := range []struct {
+		name  string
+		value string
+		max   int
+	}{
+		{"Name", bmo.Name, maxMatchFieldLength},
+		{"Address1", bmo.Address1, maxMatchFieldLength},
+		{"City", bmo.City, maxMatchFieldLength},
+		{"State", bmo.State, maxMatchStateLength},
+	} {
+		if field.value == "" {
+			ve.add(field.name, "is not set")
+		} else if len(field.value) > field.max {
+			ve.add(field.name, "must be at most %d characters: %s", field.max, field.value)
+		}
+	}
+	if len(bmo.Country) != 2 {
+		ve.add("Country", "must be a 2 letter ISO 3166-1 code: %s", bmo.Country)
+	}
+	if len(StringValue(bmo.Address2)) > maxMatchFieldLength {
+		ve.add("Address2", "must be at most %d characters: %s", maxMatchFieldLength, *bmo.Address2)
+	}
+	if len(StringValue(bmo.Address3)) > maxMatchFieldLength {
+		ve.add("Address3", "must be at most %d characters: %s", maxMatchFieldLength, *bmo.Address3)
+	}
+	if bmo.Coordinates != nil {
+		if lat := bmo.Coordinates.Latitude; lat < -90 || lat > 90 {
+			ve.add("Coordinates.Latitude", "must be between -90 and 90: %s", FloatString(lat))
+		}
+		if lng := bmo.Coordinates.Longitude; lng < -180 || lng > 180 {
+			ve.add("Coordinates.Longitude", "must be between -180 and 180: %s", FloatString(lng))
+		}
+	}
+	if bmo.Limit != nil && (*bmo.Limit < 1 || *bmo.Limit > maxMatchLimit) {
+		ve.add("Limit", "must be between 1 and %d: %d", maxMatchLimit, *bmo.Limit)
+	}
+	if bmo.MatchThreshold != nil && bmo.MatchThreshold.Validate() != nil {
+		ve.add("MatchThreshold", "is not a supported match threshold: %s", *bmo.MatchThreshold)
+	}
+	return ve.errorOrNil()
+}
+
+// URLValues returns BusinessMatchOptions as url.Values.
+func (bmo *BusinessMatchOptions) URLValues() url.Values {
+	if bmo == nil {
+		return nil
+	}
+
+	vals := url.Values{}
+	vals.Add("name", bmo.Name)
+	vals.Add("address1", bmo.Address1)
+	vals.Add("city", bmo.City)
+	vals.Add("state", bmo.State)
+	vals.Add("country", bmo.Country)
+	if bmo.Address2 != nil {
+		vals.Add("address2", *bmo.Address2)
+	}
+	if bmo.Address3 != nil {
+		vals.Add("address3", *bmo.Address3)
+	}
+	if bmo.ZipCode != nil {
+		vals.Add("zip_code", *bmo.ZipCode)
+	}
+	if bmo.Coordinates != nil {
+		vals.Add("latitude", FloatString(bmo.Coordinates.Latitude))
+		vals.Add("longitude", FloatString(bmo.Coordinates.Longitude))
+	}
+	if bmo.Phone != nil {
+		vals.Add("phone", *bmo.Phone)
+	}
+	if bmo.YelpBusinessID != nil {
+		vals.Add("yelp_business_id", *bmo.YelpBusinessID)
+	}
+	if bmo.Limit != nil {
+		vals.Add("limit", IntString(*bmo.Limit))
+	}
+	if bmo.MatchThreshold != nil {
+		vals.Add("match_threshold", string(*bmo.MatchThreshold))
+	}
+	return vals
+}
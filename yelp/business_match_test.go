@@ -0,0 +1,107 @@
+package yelp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestBusinessMatch(t *testing.T) {
+	ctx := context.Background()
+	mocks := &testMocks{}
+	options := &BusinessMatchOptions{}
+	t.Run("invalid options", func(t *testing.T) {
+		client := newTestClient(nil, "API_KEY", mocks)
+		_, err := client.BusinessMatch(ctx, options)
+		assert(t, err != nil, "Expected an error when options are invalid")
+	})
+
+	options = &BusinessMatchOptions{
+		Name:     "Pokemon Center",
+		Address1: "1 Route 1",
+		City:     "Viridian City",
+		State:    "KT",
+		Country:  "JP",
+	}
+	t.Run("failed request", func(t *testing.T) {
+		mocks.mockRequest(http.MethodGet, businessMatchPath(options), http.StatusInternalServerError, errors.New("Internal server error"))
+		client := newTestClient(mocks.server.Client(), "API_KEY", mocks)
+
+		_, err := client.BusinessMatch(ctx, options)
+		assert(t, err != nil, "Expected an error when request fails")
+	})
+
+	t.Run("successful request", func(t *testing.T) {
+		expected := BusinessMatchResults{Businesses: []Business{{ID: "pokemon-center"}}}
+		mocks.mockRequest(http.MethodGet, businessMatchPath(options), http.StatusOK, expected)
+		client := newTestClient(mocks.server.Client(), "API_KEY", mocks)
+
+		results, err := client.BusinessMatch(ctx, options)
+		assert(t, err == nil, "Expected no error (%v) when request succeeds", err)
+		assert(t, reflect.DeepEqual(*results, expected), "Results (%v) did not match expected (%v)", results, expected)
+	})
+}
+
+func TestBusinessMatchOptions(t *testing.T) {
+	valid := func() *BusinessMatchOptions {
+		return &BusinessMatchOptions{
+			Name:     "Pokemon Center",
+			Address1: "1 Route 1",
+			City:     "Viridian City",
+			State:    "KT",
+			Country:  "JP",
+		}
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		var options *BusinessMatchOptions
+		assert(t, options.Validate() != nil, "Empty options should error")
+
+		options = valid()
+		options.City = ""
+		assert(t, options.Validate() != nil, "Unset City should error")
+
+		options = valid()
+		options.Limit = Int64Pointer(maxMatchLimit + 1)
+		assert(t, options.Validate() != nil, "Limit over the max should error")
+
+		options = valid()
+		options.MatchThreshold = MatchThresholdPointer("loose")
+		assert(t, options.Validate() != nil, "Invalid MatchThreshold should error")
+
+		options = valid()
+		options.Coordinates = &Coordinates{Latitude: 91}
+		assert(t, options.Validate() != nil, "Invalid Coordinates should error")
+
+		options = valid()
+		options.Limit = Int64Pointer(1)
+		options.MatchThreshold = MatchThresholdPointer(MatchThresholdStrict)
+		assert(t, options.Validate() == nil, "Valid options should not error: %v", options.Validate())
+	})
+
+	t.Run("URLValues", func(t *testing.T) {
+		options := valid()
+		options.ZipCode = StringPointer("00001")
+		options.Coordinates = &Coordinates{Latitude: 35.5, Longitude: 139.5}
+		options.MatchThreshold = MatchThresholdPointer(MatchThresholdNone)
+		vals := options.URLValues()
+		expected := map[string]string{
+			"name":            "Pokemon Center",
+			"address1":        "1 Route 1",
+			"city":            "Viridian City",
+			"state":           "KT",
+			"country":         "JP",
+			"zip_code":        "00001",
+			"latitude":        "35.5",
+			"longitude":       "139.5",
+			"match_threshold": "none",
+		}
+		for k, v := range expected {
+			assert(t, vals.Get(k) == v, "%s: Expected \"%s\" to equal \"%s\"", k, vals.Get(k), v)
+		}
+		_, ok := vals["address2"]
+		assert(t, !ok, "Unset fields should not be included")
+	})
+}
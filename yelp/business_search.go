@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // BusinessSearchOptions contains the available parameters for the Business Search API.
@@ -14,17 +15,29 @@ type BusinessSearchOptions struct {
 	Location    *string
 	Coordinates *Coordinates
 	Radius      *int64
-	Categories  *string
+	Categories  []string
 	Locale      *string
 	Limit       *int64
 	Offset      *int64
-	SortBy      *string
-	Price       *string
+	SortBy      *SortBy
+	Price       PriceLevels
 	OpenNow     *bool
 	OpenAt      *int64
-	Attributes  *string
+	Attributes  Attributes
 }
 
+// Limits on the Business Search API parameters, as documented by Yelp.
+const (
+	// maxSearchRadius is the largest allowed radius in meters.
+	maxSearchRadius = 40000
+	// defaultSearchLimit is the number of results returned when no limit is set.
+	defaultSearchLimit = 20
+	// maxSearchLimit is the largest number of results returned per request.
+	maxSearchLimit = 50
+	// maxSearchDepth is the largest allowed offset plus limit.
+	maxSearchDepth = 1000
+)
+
 // BusinessSearchResults reflects the JSON returned by the Business Search API.
 type BusinessSearchResults struct {
 	Total      int64      `json:"total"`
@@ -47,18 +60,61 @@ func businessSearchPath(bso *BusinessSearchOptions) string {
 	return fmt.Sprintf("/v3/businesses/search?%s", bso.URLValues().Encode())
 }
 
-// Validate returns an error with deteails when BusinessSearchOptions are not valid.
+// Validate returns an error with details when BusinessSearchOptions are not valid.
+// Every invalid field is reported in the returned *ValidationError.
 func (bso *BusinessSearchOptions) Validate() error {
-	switch {
-	case bso == nil:
+	if bso == nil {
 		return errors.New("BusinessSearchOptions are unset")
-	case (bso.Location == nil) == (bso.Coordinates == nil):
-		return errors.New("BusinessSearchOptions must set either `Location` or `Coordinates`")
-	case bso.OpenNow != nil && bso.OpenAt != nil:
-		return errors.New("BusinessSearchOptions should not set both `OpenNow` and `OpenAt`")
-	default:
-		return nil
 	}
+
+	ve := &ValidationError{Options: "BusinessSearchOptions"}
+	if (bso.Location == nil) == (bso.Coordinates == nil) {
+		ve.add("Location", "or `Coordinates` must be set, but not both")
+	}
+	if bso.Coordinates != nil {
+		if lat := bso.Coordinates.Latitude; lat < -90 || lat > 90 {
+			ve.add("Coordinates.Latitude", "must be between -90 and 90: %s", FloatString(lat))
+		}
+		if lng := bso.Coordinates.Longitude; lng < -180 || lng > 180 {
+			ve.add("Coordinates.Longitude", "must be between -180 and 180: %s", FloatString(lng))
+		}
+	}
+	if bso.Radius != nil && (*bso.Radius < 0 || *bso.Radius > maxSearchRadius) {
+		ve.add("Radius", "must be between 0 and %d meters: %d", maxSearchRadius, *bso.Radius)
+	}
+	if bso.Locale != nil && ValidateLocale(*bso.Locale) != nil {
+		ve.add("Locale", "is not a supported locale: %s", *bso.Locale)
+	}
+	if bso.Limit != nil && (*bso.Limit < 0 || *bso.Limit > maxSearchLimit) {
+		ve.add("Limit", "must be between 0 and %d: %d", maxSearchLimit, *bso.Limit)
+	}
+	if bso.Offset != nil {
+		limit := Int64Value(bso.Limit)
+		if bso.Limit == nil {
+			limit = defaultSearchLimit
+		}
+		if *bso.Offset < 0 {
+			ve.add("Offset", "must not be negative: %d", *bso.Offset)
+		} else if *bso.Offset+limit > maxSearchDepth {
+			ve.add("Offset", "plus `Limit` must not exceed %d: %d", maxSearchDepth, *bso.Offset+limit)
+		}
+	}
+	if bso.SortBy != nil && bso.SortBy.Validate() != nil {
+		ve.add("SortBy", "is not a supported sort order: %s", *bso.SortBy)
+	}
+	if err := bso.Price.Validate(); err != nil {
+		ve.add("Price", "levels must be between %d and %d: %v", minPriceLevel, maxPriceLevel, err)
+	}
+	if bso.OpenNow != nil && bso.OpenAt != nil {
+		ve.add("OpenAt", "should not be set with `OpenNow`")
+	}
+	if bso.OpenAt != nil && *bso.OpenAt < 0 {
+		ve.add("OpenAt", "must not be negative: %d", *bso.OpenAt)
+	}
+	if err := bso.Attributes.Validate(); err != nil {
+		ve.add("Attributes", "are not all supported: %v", err)
+	}
+	return ve.errorOrNil()
 }
 
 // URLValues returns BusinessSearchOptions as url.Values.
@@ -80,8 +136,8 @@ func (bso *BusinessSearchOptions) URLValues() url.Values {
 	if bso.Radius != nil {
 		vals.Add("radius", IntString(*bso.Radius))
 	}
-	if bso.Categories != nil {
-		vals.Add("categories", *bso.Categories)
+	if len(bso.Categories) > 0 {
+		vals.Add("categories", strings.Join(bso.Categories, ","))
 	}
 	if bso.Locale != nil {
 		vals.Add("locale", *bso.Locale)
@@ -93,18 +149,18 @@ func (bso *BusinessSearchOptions) URLValues() url.Values {
 		vals.Add("offset", IntString(*bso.Offset))
 	}
 	if bso.SortBy != nil {
-		vals.Add("bsort_by", *bso.SortBy)
+		vals.Add("sort_by", string(*bso.SortBy))
 	}
-	if bso.Price != nil {
-		vals.Add("price", *bso.Price)
+	if len(bso.Price) > 0 {
+		vals.Add("price", bso.Price.String())
 	}
 	if bso.OpenNow != nil {
 		vals.Add("open_now", BoolString(*bso.OpenNow))
 	} else if bso.OpenAt != nil {
 		vals.Add("open_at", IntString(*bso.OpenAt))
 	}
-	if bso.Attributes != nil {
-		vals.Add("attributes", *bso.Attributes)
+	if len(bso.Attributes) > 0 {
+		vals.Add("attributes", bso.Attributes.String())
 	}
 	return vals
 }
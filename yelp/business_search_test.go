@@ -93,6 +93,65 @@ func TestBusinessSearchOptions(t *testing.T) {
 			assert(t, options.Validate() == nil, "OpenNow set should not error")
 		})
 
+		t.Run("SortBy is invalid", func(t *testing.T) {
+			options = &BusinessSearchOptions{
+				Location: StringPointer("Galar"),
+				SortBy:   SortByPointer("popularity"),
+			}
+			assert(t, options.Validate() != nil, "Invalid SortBy should error")
+		})
+
+		t.Run("Price is invalid", func(t *testing.T) {
+			options = &BusinessSearchOptions{
+				Location: StringPointer("Galar"),
+				Price:    PriceLevels{PriceModerate, 5},
+			}
+			assert(t, options.Validate() != nil, "Invalid Price should error")
+		})
+
+		t.Run("Attributes is invalid", func(t *testing.T) {
+			options = &BusinessSearchOptions{
+				Location:   StringPointer("Galar"),
+				Attributes: Attributes{AttributeDeals, "cheap"},
+			}
+			assert(t, options.Validate() != nil, "Invalid Attributes should error")
+		})
+
+		t.Run("Out of range values", func(t *testing.T) {
+			options = &BusinessSearchOptions{
+				Coordinates: &Coordinates{
+					Latitude:  91,
+					Longitude: -181,
+				},
+				Radius: Int64Pointer(40001),
+				Locale: StringPointer("en"),
+				Limit:  Int64Pointer(51),
+				OpenAt: Int64Pointer(-1),
+			}
+			err := options.Validate()
+			ve, ok := err.(*ValidationError)
+			assert(t, ok, "Expected a *ValidationError, got %T", err)
+
+			fields := map[string]bool{}
+			for _, fe := range ve.Fields {
+				fields[fe.Field] = true
+			}
+			for _, field := range []string{"Coordinates.Latitude", "Coordinates.Longitude", "Radius", "Locale", "Limit", "OpenAt"} {
+				assert(t, fields[field], "Expected %s to be reported in %v", field, err)
+			}
+		})
+
+		t.Run("Offset and Limit exceed the search depth", func(t *testing.T) {
+			options = &BusinessSearchOptions{
+				Location: StringPointer("Paldea"),
+				Offset:   Int64Pointer(990),
+			}
+			assert(t, options.Validate() != nil, "Offset plus the default Limit over 1000 should error")
+
+			options.Limit = Int64Pointer(10)
+			assert(t, options.Validate() == nil, "Offset plus Limit of 1000 should not error")
+		})
+
 		t.Run("Only OpenAt is set", func(t *testing.T) {
 			options = &BusinessSearchOptions{
 				Location: StringPointer("Alola"),
@@ -133,15 +192,15 @@ func TestBusinessSearchOptions(t *testing.T) {
 				},
 				Term:       StringPointer("restaurants"),
 				Radius:     Int64Pointer(1987),
-				Categories: StringPointer("chinese"),
+				Categories: []string{"chinese", "dimsum"},
 				Locale:     StringPointer("en_US"),
 				Limit:      Int64Pointer(23),
 				Offset:     Int64Pointer(13),
-				SortBy:     StringPointer("rating"),
-				Price:      StringPointer("1,4"),
+				SortBy:     SortByPointer(SortByRating),
+				Price:      PriceLevels{PriceUltraHighEnd, PriceInexpensive},
 				OpenNow:    BoolPointer(false),
 				OpenAt:     Int64Pointer(int64(time.Now().Second())),
-				Attributes: StringPointer("hot_and_new"),
+				Attributes: Attributes{AttributeHotAndNew, AttributeDeals},
 			}
 
 			vals := options.URLValues()
@@ -162,6 +221,14 @@ func TestBusinessSearchOptions(t *testing.T) {
 					if v != "restaurants" {
 						t.Fatalf("Term: Expected \"%s\" to equal \"restaurants\"", v)
 					}
+				case "categories":
+					assert(t, v == "chinese,dimsum", "Categories: Expected \"%s\" to equal \"chinese,dimsum\"", v)
+				case "sort_by":
+					assert(t, v == "rating", "SortBy: Expected \"%s\" to equal \"rating\"", v)
+				case "price":
+					assert(t, v == "1,4", "Price: Expected \"%s\" to equal \"1,4\"", v)
+				case "attributes":
+					assert(t, v == "hot_and_new,deals", "Attributes: Expected \"%s\" to equal \"hot_and_new,deals\"", v)
 				}
 			}
 		})
@@ -0,0 +1,118 @@
+package yelp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CategoriesOptions contains the available parameters for the All Categories
+// API.
+type CategoriesOptions struct {
+	Locale *string
+}
+
+// GetCategoryOptions contains the available parameters for the Category Details
+// API.
+type GetCategoryOptions struct {
+	Alias  string
+	Locale *string
+}
+
+// CategoriesResults reflects the JSON returned by the All Categories API.
+type CategoriesResults struct {
+	Categories []CategoryDetails `json:"categories"`
+}
+
+// CategoryDetails describes a category and where it is used.
+type CategoryDetails struct {
+	Alias         string   `json:"alias"`
+	Title         string   `json:"title"`
+	ParentAliases []string `json:"parent_aliases"`
+	// CountryWhitelist lists the only countries the category is used in, if set.
+	CountryWhitelist []string `json:"country_whitelist"`
+	// CountryBlacklist lists the countries the category is not used in.
+	CountryBlacklist []string `json:"country_blacklist"`
+}
+
+// categoryResponse reflects the JSON returned by the Category Details API.
+type categoryResponse struct {
+	Category CategoryDetails `json:"category"`
+}
+
+// Categories makes a request given the options provided, which may be nil.
+func (c *client) Categories(ctx context.Context, co *CategoriesOptions) (*CategoriesResults, error) {
+	if err := co.Validate(); err != nil {
+		return nil, err
+	}
+	var respBody CategoriesResults
+	_, err := c.authedDo(ctx, http.MethodGet, categoriesPath(co), nil, nil, &respBody)
+	return &respBody, err
+}
+
+// GetCategory makes a request given the options provided.
+func (c *client) GetCategory(ctx context.Context, gco *GetCategoryOptions) (*CategoryDetails, error) {
+	if err := gco.Validate(); err != nil {
+		return nil, err
+	}
+	var respBody categoryResponse
+	_, err := c.authedDo(ctx, http.MethodGet, getCategoryPath(gco), nil, nil, &respBody)
+	return &respBody.Category, err
+}
+
+// categoriesPath returns the all categories path with parameters.
+func categoriesPath(co *CategoriesOptions) string {
+	return fmt.Sprintf("/v3/categories?%s", co.URLValues().Encode())
+}
+
+// getCategoryPath returns the category details path with parameters.
+func getCategoryPath(gco *GetCategoryOptions) string {
+	return fmt.Sprintf("/v3/categories/%s?%s", url.PathEscape(gco.Alias), gco.URLValues().Encode())
+}
+
+// Validate returns an error with details when CategoriesOptions are not valid.
+// Nil options are valid.
+func (co *CategoriesOptions) Validate() error {
+	if co != nil && co.Locale != nil && ValidateLocale(*co.Locale) != nil {
+		return fmt.Errorf("CategoriesOptions `Locale` is invalid: %s", *co.Locale)
+	}
+	return nil
+}
+
+// URLValues returns CategoriesOptions as url.Values.
+func (co *CategoriesOptions) URLValues() url.Values {
+	vals := url.Values{}
+	if co != nil && co.Locale != nil {
+		vals.Add("locale", *co.Locale)
+	}
+	return vals
+}
+
+// Validate returns an error with details when GetCategoryOptions are not valid.
+func (gco *GetCategoryOptions) Validate() error {
+	switch {
+	case gco == nil:
+		return errors.New("GetCategoryOptions are unset")
+	case gco.Alias == "":
+		return errors.New("GetCategoryOptions `Alias` is not set")
+	case gco.Locale != nil && ValidateLocale(*gco.Locale) != nil:
+		return fmt.Errorf("GetCategoryOptions `Locale` is invalid: %s", *gco.Locale)
+	default:
+		return nil
+	}
+}
+
+// URLValues returns GetCategoryOptions as url.Values.
+func (gco *GetCategoryOptions) URLValues() url.Values {
+	if gco == nil {
+		return nil
+	}
+
+	vals := url.Values{}
+	if gco.Locale != nil {
+		vals.Add("locale", *gco.Locale)
+	}
+	return vals
+}
@@ -0,0 +1,61 @@
+package yelp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCategories(t *testing.T) {
+	ctx := context.Background()
+	mocks := &testMocks{}
+	options := &CategoriesOptions{Locale: StringPointer("en")}
+	t.Run("invalid options", func(t *testing.T) {
+		client := newTestClient(nil, "API_KEY", mocks)
+		_, err := client.Categories(ctx, options)
+		assert(t, err != nil, "Expected an error when options are invalid")
+	})
+
+	t.Run("failed request", func(t *testing.T) {
+		options.Locale = nil
+		mocks.mockRequest(http.MethodGet, categoriesPath(options), http.StatusInternalServerError, errors.New("Internal server error"))
+		client := newTestClient(mocks.server.Client(), "API_KEY", mocks)
+
+		_, err := client.Categories(ctx, options)
+		assert(t, err != nil, "Expected an error when request fails")
+	})
+
+	t.Run("successful request", func(t *testing.T) {
+		expected := CategoriesResults{Categories: []CategoryDetails{{Alias: "hotdogs", Title: "Fast Food", ParentAliases: []string{"restaurants"}}}}
+		mocks.mockRequest(http.MethodGet, categoriesPath(nil), http.StatusOK, expected)
+		client := newTestClient(mocks.server.Client(), "API_KEY", mocks)
+
+		results, err := client.Categories(ctx, nil)
+		assert(t, err == nil, "Expected no error (%v) when request succeeds", err)
+		assert(t, reflect.DeepEqual(*results, expected), "Results (%v) did not match expected (%v)", results, expected)
+	})
+}
+
+func TestGetCategory(t *testing.T) {
+	ctx := context.Background()
+	mocks := &testMocks{}
+	options := &GetCategoryOptions{}
+	t.Run("invalid options", func(t *testing.T) {
+		client := newTestClient(nil, "API_KEY", mocks)
+		_, err := client.GetCategory(ctx, options)
+		assert(t, err != nil, "Expected an error when options are invalid")
+	})
+
+	t.Run("successful request", func(t *testing.T) {
+		expected := CategoryDetails{Alias: "hotdogs", Title: "Fast Food", ParentAliases: []string{"restaurants"}}
+		options.Alias = "hotdogs"
+		mocks.mockRequest(http.MethodGet, getCategoryPath(options), http.StatusOK, map[string]interface{}{"category": expected})
+		client := newTestClient(mocks.server.Client(), "API_KEY", mocks)
+
+		results, err := client.GetCategory(ctx, options)
+		assert(t, err == nil, "Expected no error (%v) when request succeeds", err)
+		assert(t, reflect.DeepEqual(*results, expected), "Results (%v) did not match expected (%v)", results, expected)
+	})
+}
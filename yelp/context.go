@@ -0,0 +1,88 @@
+package yelp
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// contextKey is the type of the context keys of this package.
+type contextKey int
+
+// Context keys of the per-request overrides.
+const (
+	apiKeyContextKey contextKey = iota
+	localeContextKey
+)
+
+// WithAPIKey returns a copy of ctx which makes requests use apiKey instead of
+// the client's api key or key pool.
+func WithAPIKey(ctx context.Context, apiKey string) context.Context {
+	return context.WithValue(ctx, apiKeyContextKey, apiKey)
+}
+
+// APIKeyFromContext returns the api key set on ctx by WithAPIKey.
+func APIKeyFromContext(ctx context.Context) (string, bool) {
+	apiKey, ok := ctx.Value(apiKeyContextKey).(string)
+	return apiKey, ok
+}
+
+// WithLocale returns a copy of ctx which makes requests whose options leave
+// `Locale` unset use locale. It only applies to the endpoints which document a
+// `locale` parameter: Business Search, Phone Search, Get Business and Reviews.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeContextKey, locale)
+}
+
+// LocaleFromContext returns the locale set on ctx by WithLocale.
+func LocaleFromContext(ctx context.Context) (string, bool) {
+	locale, ok := ctx.Value(localeContextKey).(string)
+	return locale, ok
+}
+
+// contextLocalePath adds the locale set on ctx to the query of path, unless the
+// endpoint does not take a locale or the query already has one.
+func contextLocalePath(ctx context.Context, path string) (string, error) {
+	locale, ok := LocaleFromContext(ctx)
+	if !ok {
+		return path, nil
+	}
+	u, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+	if !takesLocale(u.EscapedPath()) {
+		return path, nil
+	}
+	if err := ValidateLocale(locale); err != nil {
+		return "", fmt.Errorf("context locale is invalid: %v", err)
+	}
+
+	query := u.Query()
+	if query.Get("locale") != "" {
+		return path, nil
+	}
+	query.Set("locale", locale)
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// takesLocale returns whether the endpoint at the escaped path documents a
+// `locale` parameter, which are /v3/businesses/search, /v3/businesses/{id},
+// /v3/businesses/search/phone and /v3/businesses/{id}/reviews.
+func takesLocale(path string) bool {
+	const prefix = "/v3/businesses/"
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	parts := strings.Split(strings.TrimPrefix(path, prefix), "/")
+	switch len(parts) {
+	case 1:
+		return parts[0] != "" && parts[0] != "matches"
+	case 2:
+		return parts[0] == "search" && parts[1] == "phone" || parts[0] != "search" && parts[1] == "reviews"
+	default:
+		return false
+	}
+}
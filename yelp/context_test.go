@@ -0,0 +1,71 @@
+package yelp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestContextOverrides(t *testing.T) {
+	var auth, locale, query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth, locale, query = r.Header.Get("Authorization"), r.URL.Query().Get("locale"), r.URL.RawQuery
+		w.Write([]byte(`{"id":"ditto","businesses":[]}`))
+	}))
+	defer server.Close()
+
+	pool := NewKeyPool("pool-key")
+	c := &client{Client: server.Client(), host: server.URL, apiKey: "client-key"}
+	pooled := &client{Client: server.Client(), host: server.URL, keys: pool}
+
+	t.Run("No overrides", func(t *testing.T) {
+		_, err := c.GetBusiness(context.Background(), &GetBusinessOptions{ID: "ditto"})
+		assert(t, err == nil, "Expected the request to succeed (%v)", err)
+		assert(t, auth == "Bearer client-key" && locale == "", "Unexpected key %q and locale %q", auth, locale)
+	})
+
+	t.Run("Context API key", func(t *testing.T) {
+		ctx := WithAPIKey(context.Background(), "tenant-key")
+		_, err := c.GetBusiness(ctx, &GetBusinessOptions{ID: "ditto"})
+		assert(t, err == nil && auth == "Bearer tenant-key", "Expected the context key, got %q (%v)", auth, err)
+
+		_, err = pooled.GetBusiness(ctx, &GetBusinessOptions{ID: "ditto"})
+		assert(t, err == nil && auth == "Bearer tenant-key", "Expected the context key over the pool, got %q (%v)", auth, err)
+		assert(t, pool.Usage()[0].Requests == 0, "Expected the pool to be unused")
+	})
+
+	t.Run("Context locale", func(t *testing.T) {
+		ctx := WithLocale(context.Background(), "fr_FR")
+		_, err := c.GetBusiness(ctx, &GetBusinessOptions{ID: "ditto"})
+		assert(t, err == nil && locale == "fr_FR", "Expected the context locale, got %q (%v)", locale, err)
+
+		_, err = c.BusinessSearch(ctx, &BusinessSearchOptions{Location: StringPointer("Pallet Town")})
+		assert(t, err == nil && locale == "fr_FR", "Expected the context locale on search, got %q (%v)", locale, err)
+
+		_, err = c.GetBusiness(ctx, &GetBusinessOptions{ID: "ditto", Locale: StringPointer("ja_JP")})
+		assert(t, err == nil && locale == "ja_JP", "Expected the options locale to win, got %q (%v)", locale, err)
+
+		_, err = c.Reviews(ctx, &ReviewsOptions{ID: "ditto"})
+		assert(t, err == nil && locale == "fr_FR", "Expected the context locale on reviews, got %q (%v)", locale, err)
+	})
+
+	t.Run("Endpoints without a locale", func(t *testing.T) {
+		ctx := WithLocale(context.Background(), "fr_FR")
+		match := &BusinessMatchOptions{Name: "Ditto", Address1: "1 Route 1", City: "Cerulean", State: "KT", Country: "JP"}
+		_, err := c.BusinessMatch(ctx, match)
+		assert(t, err == nil && query == match.URLValues().Encode(), "Expected the match request to be unchanged, got %q (%v)", query, err)
+
+		_, err = c.Autocomplete(ctx, &AutocompleteOptions{Text: "dit"})
+		assert(t, err == nil && locale == "", "Expected no locale on autocomplete, got %q (%v)", locale, err)
+
+		_, err = c.Categories(WithLocale(context.Background(), "kanto"), nil)
+		assert(t, err == nil && locale == "", "Expected the context locale to be ignored on categories, got %q (%v)", locale, err)
+	})
+
+	t.Run("Invalid context locale", func(t *testing.T) {
+		ctx := WithLocale(context.Background(), "kanto")
+		_, err := c.GetBusiness(ctx, &GetBusinessOptions{ID: "ditto"})
+		assert(t, err != nil, "Expected an invalid context locale to fail")
+	})
+}
@@ -0,0 +1,155 @@
+package yelp
+
+import (
+	"reflect"
+	"sort"
+)
+
+// ChangeType is the kind of change between two snapshots of a business.
+type ChangeType string
+
+// The kinds of changes detected by Diff and DiffSnapshots.
+const (
+	ChangeAdded           ChangeType = "added"
+	ChangeRemoved         ChangeType = "removed"
+	ChangeClosed          ChangeType = "closed"
+	ChangeRating          ChangeType = "rating"
+	ChangeReviewCount     ChangeType = "review_count"
+	ChangePrice           ChangeType = "price"
+	ChangeHours           ChangeType = "hours"
+	ChangeSpecialHours    ChangeType = "special_hours"
+	ChangeAddress         ChangeType = "address"
+	ChangePhone           ChangeType = "phone"
+	ChangeCategoryAdded   ChangeType = "category_added"
+	ChangeCategoryRemoved ChangeType = "category_removed"
+)
+
+// Change is a change between two snapshots of a business. Old and New are the
+// values of the changed field, and Delta is set for numeric fields.
+type Change struct {
+	Type       ChangeType  `json:"type"`
+	BusinessID string      `json:"business_id"`
+	Old        interface{} `json:"old,omitempty"`
+	New        interface{} `json:"new,omitempty"`
+	Delta      float64     `json:"delta,omitempty"`
+}
+
+// Diff returns the changes from old to new, which are snapshots of the same
+// business.
+func Diff(old, new Business) []Change {
+	id := new.ID
+	var changes []Change
+	if old.IsClosed != new.IsClosed {
+		changes = append(changes, Change{Type: ChangeClosed, BusinessID: id, Old: old.IsClosed, New: new.IsClosed})
+	}
+	if old.Rating != new.Rating {
+		changes = append(changes, Change{Type: ChangeRating, BusinessID: id, Old: old.Rating, New: new.Rating, Delta: new.Rating - old.Rating})
+	}
+	if old.ReviewCount != new.ReviewCount {
+		changes = append(changes, Change{Type: ChangeReviewCount, BusinessID: id, Old: old.ReviewCount, New: new.ReviewCount, Delta: float64(new.ReviewCount - old.ReviewCount)})
+	}
+	if old.Price != new.Price {
+		changes = append(changes, Change{Type: ChangePrice, BusinessID: id, Old: old.Price, New: new.Price})
+	}
+	if !equalSchedules(old.Hours, new.Hours) {
+		changes = append(changes, Change{Type: ChangeHours, BusinessID: id, Old: old.Hours, New: new.Hours})
+	}
+	if !equalOrEmpty(old.SpecialHours, new.SpecialHours) {
+		changes = append(changes, Change{Type: ChangeSpecialHours, BusinessID: id, Old: old.SpecialHours, New: new.SpecialHours})
+	}
+	if !equalLocations(old.Location, new.Location) {
+		changes = append(changes, Change{Type: ChangeAddress, BusinessID: id, Old: old.Location, New: new.Location})
+	}
+	// the display phone is reported when only its formatting changed
+	if old.Phone != new.Phone {
+		changes = append(changes, Change{Type: ChangePhone, BusinessID: id, Old: old.Phone, New: new.Phone})
+	} else if old.DisplayPhone != new.DisplayPhone {
+		changes = append(changes, Change{Type: ChangePhone, BusinessID: id, Old: old.DisplayPhone, New: new.DisplayPhone})
+	}
+
+	oldCategories := map[string]bool{}
+	for _, c := range old.Categories {
+		oldCategories[c.Alias] = true
+	}
+	newCategories := map[string]bool{}
+	for _, c := range new.Categories {
+		newCategories[c.Alias] = true
+		if !oldCategories[c.Alias] {
+			changes = append(changes, Change{Type: ChangeCategoryAdded, BusinessID: id, New: c})
+		}
+	}
+	for _, c := range old.Categories {
+		if !newCategories[c.Alias] {
+			changes = append(changes, Change{Type: ChangeCategoryRemoved, BusinessID: id, Old: c})
+		}
+	}
+	return changes
+}
+
+// DiffSnapshots returns the changes from old to new, which are snapshots of
+// businesses keyed by ID. Changes are ordered by business ID.
+func DiffSnapshots(old, new map[string]Business) []Change {
+	ids := make([]string, 0, len(old)+len(new))
+	for id := range old {
+		ids = append(ids, id)
+	}
+	for id := range new {
+		if _, ok := old[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	var changes []Change
+	for _, id := range ids {
+		o, inOld := old[id]
+		n, inNew := new[id]
+		switch {
+		case !inOld:
+			changes = append(changes, Change{Type: ChangeAdded, BusinessID: id, New: n})
+		case !inNew:
+			changes = append(changes, Change{Type: ChangeRemoved, BusinessID: id, Old: o})
+		default:
+			changes = append(changes, Diff(o, n)...)
+		}
+	}
+	return changes
+}
+
+// equalSchedules returns whether a and b have the same opening hours. Whether
+// the business is open now is ignored, since it changes without the hours
+// changing.
+func equalSchedules(a, b []Hours) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].HoursType != b[i].HoursType || !equalOrEmpty(a[i].Open, b[i].Open) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalLocations returns whether a and b are the same address, treating a nil
+// and empty `DisplayAddress` as equal.
+func equalLocations(a, b Location) bool {
+	return a.Address1 == b.Address1 &&
+		a.Address2 == b.Address2 &&
+		a.Address3 == b.Address3 &&
+		a.City == b.City &&
+		a.Country == b.Country &&
+		a.State == b.State &&
+		a.ZipCode == b.ZipCode &&
+		equalOrEmpty(a.DisplayAddress, b.DisplayAddress)
+}
+
+// equalOrEmpty returns whether a and b are deeply equal, treating nil and empty
+// slices as equal.
+func equalOrEmpty(a, b interface{}) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Len() == 0 && vb.Len() == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,98 @@
+package yelp
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	old := Business{
+		ID:          "pokemon-center",
+		IsClosed:    false,
+		Rating:      4.5,
+		ReviewCount: 100,
+		Price:       "$",
+		Phone:       "+14155550100",
+		Location:    Location{Address1: "1 Route 1", City: "Viridian"},
+		Categories:  []Category{{Alias: "hospitals", Title: "Hospitals"}, {Alias: "pets", Title: "Pets"}},
+		Hours:       []Hours{{HoursType: HoursTypeRegular, Open: []Open{{Day: 0, Start: "0000", End: "0000"}}}},
+	}
+
+	t.Run("No changes", func(t *testing.T) {
+		same := old
+		same.Distance = 10
+		same.SpecialHours = []SpecialHours{}
+		same.Location.DisplayAddress = []string{}
+		assert(t, len(Diff(old, same)) == 0, "Expected no changes: %+v", Diff(old, same))
+	})
+
+	t.Run("Display address", func(t *testing.T) {
+		moved := old
+		moved.Location = Location{Address1: "1 Route 1", City: "Viridian", DisplayAddress: []string{"1 Route 1", "Viridian"}}
+		changes := Diff(old, moved)
+		assert(t, len(changes) == 1 && changes[0].Type == ChangeAddress, "Expected an address change: %+v", changes)
+	})
+
+	t.Run("Opening and closing", func(t *testing.T) {
+		open := old
+		open.Hours = []Hours{{IsOpenNow: true, HoursType: HoursTypeRegular, Open: old.Hours[0].Open}}
+		assert(t, len(Diff(old, open)) == 0, "Expected no changes when only IsOpenNow flips: %+v", Diff(old, open))
+	})
+
+	t.Run("Display phone", func(t *testing.T) {
+		old := old
+		old.DisplayPhone = "+1 415-555-0100"
+		new := old
+		new.DisplayPhone = "(415) 555-0100"
+		changes := Diff(old, new)
+		assert(t, len(changes) == 1 && changes[0].Type == ChangePhone, "Expected a phone change: %+v", changes)
+		assert(t, changes[0].Old == "+1 415-555-0100" && changes[0].New == "(415) 555-0100", "Expected the display phones to be reported: %+v", changes[0])
+	})
+
+	t.Run("Every change", func(t *testing.T) {
+		new := old
+		new.IsClosed = true
+		new.Rating = 4
+		new.ReviewCount = 103
+		new.Price = "$$"
+		new.Phone = "+14155550199"
+		new.Location = Location{Address1: "2 Route 2", City: "Viridian"}
+		new.Categories = []Category{{Alias: "pets", Title: "Pets"}, {Alias: "vets", Title: "Veterinarians"}}
+		new.Hours = []Hours{{HoursType: HoursTypeRegular, Open: []Open{{Day: 0, Start: "0900", End: "1700"}}}}
+
+		types := map[ChangeType]Change{}
+		for _, c := range Diff(old, new) {
+			assert(t, c.BusinessID == "pokemon-center", "Expected the business ID to be set: %+v", c)
+			types[c.Type] = c
+		}
+		for _, ct := range []ChangeType{ChangeClosed, ChangeRating, ChangeReviewCount, ChangePrice, ChangeHours, ChangeAddress, ChangePhone, ChangeCategoryAdded, ChangeCategoryRemoved} {
+			_, ok := types[ct]
+			assert(t, ok, "Expected a %s change", ct)
+		}
+		assert(t, types[ChangeRating].Delta == -0.5, "Expected a rating delta of -0.5, got %v", types[ChangeRating].Delta)
+		assert(t, types[ChangeReviewCount].Delta == 3, "Expected a review count delta of 3, got %v", types[ChangeReviewCount].Delta)
+		assert(t, types[ChangeCategoryAdded].New.(Category).Alias == "vets", "Expected vets to be added")
+		assert(t, types[ChangeCategoryRemoved].Old.(Category).Alias == "hospitals", "Expected hospitals to be removed")
+
+		b, err := json.Marshal(types[ChangeRating])
+		assert(t, err == nil, "Expected no error (%v) marshaling a change", err)
+		expected := `{"type":"rating","business_id":"pokemon-center","old":4.5,"new":4,"delta":-0.5}`
+		assert(t, string(b) == expected, "Expected JSON %s to equal %s", b, expected)
+	})
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	old := map[string]Business{
+		"a": {ID: "a", Rating: 3},
+		"b": {ID: "b"},
+	}
+	new := map[string]Business{
+		"a": {ID: "a", Rating: 3.5},
+		"c": {ID: "c"},
+	}
+	changes := DiffSnapshots(old, new)
+	assert(t, len(changes) == 3, "Expected 3 changes, got %+v", changes)
+	assert(t, changes[0].Type == ChangeRating && changes[0].BusinessID == "a", "Unexpected change: %+v", changes[0])
+	assert(t, changes[1].Type == ChangeRemoved && changes[1].BusinessID == "b", "Unexpected change: %+v", changes[1])
+	assert(t, changes[2].Type == ChangeAdded && changes[2].BusinessID == "c", "Unexpected change: %+v", changes[2])
+}
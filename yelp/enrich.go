@@ -0,0 +1,104 @@
+package yelp
+
+import (
+	"context"
+	"time"
+)
+
+// EnrichedSearchResults are Business Search results whose businesses were
+// merged with their Get Business details.
+type EnrichedSearchResults struct {
+	BusinessSearchResults
+
+	// Merged contains the merged businesses, indexed like Businesses, with the
+	// source of each field.
+	Merged []MergedBusiness
+
+	// Failed lists the businesses whose details could not be fetched. They keep
+	// the fields of the search result.
+	Failed []EnrichFailure
+}
+
+// EnrichFailure is a business whose details could not be fetched.
+type EnrichFailure struct {
+	// Index is the position of the business in the search results.
+	Index      int
+	BusinessID string
+	Err        error
+}
+
+// EnrichBusinessSearch makes a Business Search request, and then enriches the
+// results with Enrich. The locale of the options, if any, is also used for the
+// Get Business requests. Only the search failing returns an error.
+func EnrichBusinessSearch(ctx context.Context, c Client, bso *BusinessSearchOptions, workers int) (*EnrichedSearchResults, error) {
+	searchedAt := time.Now()
+	results, err := c.BusinessSearch(ctx, bso)
+	if err != nil {
+		return nil, err
+	}
+	if bso.Locale != nil {
+		ctx = WithLocale(ctx, *bso.Locale)
+	}
+	return Enrich(ctx, c, results, searchedAt, workers), nil
+}
+
+// Enrich makes a Get Business request for each of the businesses of results
+// using up to workers concurrent requests, which wait on the rate limiter of
+// c if it has one, and merges the details into the businesses with Merge.
+// searchedAt is when results were fetched.
+func Enrich(ctx context.Context, c Client, results *BusinessSearchResults, searchedAt time.Time, workers int) *EnrichedSearchResults {
+	enriched := &EnrichedSearchResults{
+		BusinessSearchResults: *results,
+		Merged:                make([]MergedBusiness, len(results.Businesses)),
+	}
+	enriched.Businesses = make([]Business, len(results.Businesses))
+
+	ids := make([]string, len(results.Businesses))
+	for i, b := range results.Businesses {
+		ids[i] = b.ID
+	}
+	in := make(chan *GetBusinessOptions)
+	go func() {
+		defer close(in)
+		for _, gbo := range BusinessIDs(ids...) {
+			select {
+			case in <- gbo:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	details := make([]*SourcedBusiness, len(ids))
+	errs := make([]error, len(ids))
+	for r := range StreamGetBusiness(ctx, c, in, workers) {
+		if r.Err != nil {
+			errs[r.Index] = r.Err
+			continue
+		}
+		details[r.Index] = &SourcedBusiness{Business: *r.Business, Source: SourceDetails, FetchedAt: time.Now()}
+	}
+
+	for i, b := range results.Businesses {
+		search := SourcedBusiness{Business: b, Source: SourceSearch, FetchedAt: searchedAt}
+		var detail SourcedBusiness
+		if details[i] != nil {
+			detail = *details[i]
+		} else if errs[i] == nil {
+			// the request was never made because ctx is done
+			errs[i] = ctx.Err()
+		}
+
+		merged, err := Merge(search, detail)
+		if err != nil {
+			errs[i] = err
+			merged, _ = Merge(search, SourcedBusiness{})
+		}
+		if errs[i] != nil {
+			enriched.Failed = append(enriched.Failed, EnrichFailure{Index: i, BusinessID: b.ID, Err: errs[i]})
+		}
+		enriched.Merged[i] = *merged
+		enriched.Businesses[i] = merged.Business
+	}
+	return enriched
+}
@@ -0,0 +1,67 @@
+package yelp
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEnrichBusinessSearch(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		locale string
+	)
+	c := &fakeClient{
+		businessSearch: func(ctx context.Context, bso *BusinessSearchOptions) (*BusinessSearchResults, error) {
+			if StringValue(bso.Location) == "Cinnabar Island" {
+				return nil, errors.New("volcano erupted")
+			}
+			return &BusinessSearchResults{
+				Total: 3,
+				Businesses: []Business{
+					{ID: "pewter-gym", Name: "Pewter Gym", Distance: 10},
+					{ID: "cerulean-gym", Name: "Cerulean Gym", Distance: 20},
+					{ID: "vermilion-gym", Name: "Vermilion Gym", Distance: 30},
+				},
+			}, nil
+		},
+		getBusiness: func(ctx context.Context, gbo *GetBusinessOptions) (*Business, error) {
+			mu.Lock()
+			locale, _ = LocaleFromContext(ctx)
+			mu.Unlock()
+			if gbo.ID == "cerulean-gym" {
+				return nil, errors.New("gym leader is out")
+			}
+			return &Business{ID: gbo.ID, Name: "Details", Photos: []string{gbo.ID + ".jpg"}}, nil
+		},
+	}
+	ctx := context.Background()
+
+	t.Run("Results are enriched", func(t *testing.T) {
+		results, err := EnrichBusinessSearch(ctx, c, &BusinessSearchOptions{Location: StringPointer("Kanto"), Locale: StringPointer("en_GB")}, 2)
+		assert(t, err == nil && results.Total == 3 && len(results.Businesses) == 3, "Expected 3 results (%v)", err)
+		assert(t, locale == "en_GB", "Expected the search locale to be used for details, got %q", locale)
+
+		pewter := results.Businesses[0]
+		assert(t, pewter.Name == "Details" && pewter.Distance == 10 && len(pewter.Photos) == 1, "Unexpected enriched business %+v", pewter)
+		assert(t, results.Merged[0].Sources["photos"].Source == SourceDetails, "Expected the photos to come from the details")
+
+		assert(t, len(results.Failed) == 1 && results.Failed[0].Index == 1 && results.Failed[0].BusinessID == "cerulean-gym", "Unexpected failures %+v", results.Failed)
+		assert(t, results.Businesses[1].Name == "Cerulean Gym" && results.Merged[1].Sources["name"].Source == SourceSearch, "Expected the failed business to keep its search fields")
+	})
+
+	t.Run("Failed search", func(t *testing.T) {
+		_, err := EnrichBusinessSearch(ctx, c, &BusinessSearchOptions{Location: StringPointer("Cinnabar Island")}, 2)
+		assert(t, err != nil, "Expected the search error")
+	})
+
+	t.Run("Cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		results, _ := c.BusinessSearch(ctx, &BusinessSearchOptions{})
+		enriched := Enrich(ctx, c, results, time.Now(), 2)
+		assert(t, len(enriched.Failed) == 3 && len(enriched.Businesses) == 3, "Expected every business to fail, got %+v", enriched.Failed)
+	})
+}
@@ -0,0 +1,49 @@
+package yelp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FieldError describes why a single field of a set of options is invalid.
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+// Error implements the error interface.
+func (fe FieldError) Error() string {
+	return fmt.Sprintf("`%s` %s", fe.Field, fe.Reason)
+}
+
+// ValidationError lists every invalid field found while validating a set of
+// options, so that all of the problems can be reported at once.
+type ValidationError struct {
+	Options string
+	Fields  []FieldError
+}
+
+// Error implements the error interface.
+func (ve *ValidationError) Error() string {
+	reasons := make([]string, len(ve.Fields))
+	for i, fe := range ve.Fields {
+		reasons[i] = fe.Error()
+	}
+	return fmt.Sprintf("%s are invalid: %s", ve.Options, strings.Join(reasons, "; "))
+}
+
+// add records that field is invalid for the given reason.
+func (ve *ValidationError) add(field string, reasonFormat string, values ...interface{}) {
+	ve.Fields = append(ve.Fields, FieldError{
+		Field:  field,
+		Reason: fmt.Sprintf(reasonFormat, values...),
+	})
+}
+
+// errorOrNil returns ve if any invalid fields were recorded, nil otherwise.
+func (ve *ValidationError) errorOrNil() error {
+	if len(ve.Fields) == 0 {
+		return nil
+	}
+	return ve
+}
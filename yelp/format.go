@@ -0,0 +1,393 @@
+package yelp
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Formatter formats business hours, addresses and prices for one of Yelp's
+// supported locales.
+type Formatter struct {
+	locale string
+	lang   languageFormat
+	clock  clockFormat
+	region string
+}
+
+// languageFormat contains the words of a language used by a Formatter.
+type languageFormat struct {
+	// weekdays are the names of the days, indexed by Yelp's day numbering (0 is
+	// Monday).
+	weekdays [7]string
+	closed   string
+	// prices describe each price level, from PriceInexpensive to
+	// PriceUltraHighEnd.
+	prices [4]string
+}
+
+// clockFormat is how a locale writes times of day.
+type clockFormat struct {
+	twelveHour bool
+	am, pm     string
+	// prefixPeriod puts the am/pm marker before the time, ie. "下午3:30".
+	prefixPeriod bool
+	separator    string
+}
+
+// languageFormats are the formats of the languages of validLocales.
+var languageFormats = map[string]languageFormat{
+	"cs": {
+		weekdays: [7]string{"pondělí", "úterý", "středa", "čtvrtek", "pátek", "sobota", "neděle"},
+		closed:   "Zavřeno",
+		prices:   [4]string{"Levné", "Středně drahé", "Drahé", "Velmi drahé"},
+	},
+	"da": {
+		weekdays: [7]string{"mandag", "tirsdag", "onsdag", "torsdag", "fredag", "lørdag", "søndag"},
+		closed:   "Lukket",
+		prices:   [4]string{"Billigt", "Moderat", "Dyrt", "Meget dyrt"},
+	},
+	"de": {
+		weekdays: [7]string{"Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag", "Sonntag"},
+		closed:   "Geschlossen",
+		prices:   [4]string{"Günstig", "Mittelpreisig", "Gehoben", "Sehr gehoben"},
+	},
+	"en": {
+		weekdays: [7]string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"},
+		closed:   "Closed",
+		prices:   [4]string{"Inexpensive", "Moderate", "Pricey", "Ultra High-End"},
+	},
+	"es": {
+		weekdays: [7]string{"lunes", "martes", "miércoles", "jueves", "viernes", "sábado", "domingo"},
+		closed:   "Cerrado",
+		prices:   [4]string{"Económico", "Moderado", "Caro", "Muy caro"},
+	},
+	"fi": {
+		weekdays: [7]string{"maanantai", "tiistai", "keskiviikko", "torstai", "perjantai", "lauantai", "sunnuntai"},
+		closed:   "Suljettu",
+		prices:   [4]string{"Edullinen", "Kohtuuhintainen", "Kallis", "Erittäin kallis"},
+	},
+	"fil": {
+		weekdays: [7]string{"Lunes", "Martes", "Miyerkules", "Huwebes", "Biyernes", "Sabado", "Linggo"},
+		closed:   "Sarado",
+		prices:   [4]string{"Mura", "Katamtaman", "Mahal", "Napakamahal"},
+	},
+	"fr": {
+		weekdays: [7]string{"lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi", "dimanche"},
+		closed:   "Fermé",
+		prices:   [4]string{"Bon marché", "Prix moyen", "Cher", "Très cher"},
+	},
+	"it": {
+		weekdays: [7]string{"lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato", "domenica"},
+		closed:   "Chiuso",
+		prices:   [4]string{"Economico", "Prezzo medio", "Costoso", "Molto costoso"},
+	},
+	"ja": {
+		weekdays: [7]string{"月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日", "日曜日"},
+		closed:   "定休日",
+		prices:   [4]string{"安い", "普通", "高め", "非常に高い"},
+	},
+	"ms": {
+		weekdays: [7]string{"Isnin", "Selasa", "Rabu", "Khamis", "Jumaat", "Sabtu", "Ahad"},
+		closed:   "Tutup",
+		prices:   [4]string{"Murah", "Sederhana", "Mahal", "Sangat mahal"},
+	},
+	"nb": {
+		weekdays: [7]string{"mandag", "tirsdag", "onsdag", "torsdag", "fredag", "lørdag", "søndag"},
+		closed:   "Stengt",
+		prices:   [4]string{"Rimelig", "Moderat", "Dyrt", "Svært dyrt"},
+	},
+	"nl": {
+		weekdays: [7]string{"maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag", "zondag"},
+		closed:   "Gesloten",
+		prices:   [4]string{"Goedkoop", "Gemiddeld", "Prijzig", "Zeer prijzig"},
+	},
+	"pl": {
+		weekdays: [7]string{"poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota", "niedziela"},
+		closed:   "Zamknięte",
+		prices:   [4]string{"Tanio", "Umiarkowanie", "Drogo", "Bardzo drogo"},
+	},
+	"pt": {
+		weekdays: [7]string{"segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado", "domingo"},
+		closed:   "Fechado",
+		prices:   [4]string{"Barato", "Moderado", "Caro", "Muito caro"},
+	},
+	"sv": {
+		weekdays: [7]string{"måndag", "tisdag", "onsdag", "torsdag", "fredag", "lördag", "söndag"},
+		closed:   "Stängt",
+		prices:   [4]string{"Billigt", "Måttligt", "Dyrt", "Mycket dyrt"},
+	},
+	"tr": {
+		weekdays: [7]string{"Pazartesi", "Salı", "Çarşamba", "Perşembe", "Cuma", "Cumartesi", "Pazar"},
+		closed:   "Kapalı",
+		prices:   [4]string{"Ucuz", "Orta", "Pahalı", "Çok pahalı"},
+	},
+	"zh": {
+		weekdays: [7]string{"星期一", "星期二", "星期三", "星期四", "星期五", "星期六", "星期日"},
+		closed:   "休息",
+		prices:   [4]string{"便宜", "中等", "偏貴", "非常昂貴"},
+	},
+}
+
+// clock24 is the 24-hour clock used by most locales.
+var clock24 = clockFormat{separator: ":"}
+
+// clockFormats are the clocks of the locales which do not use clock24.
+var clockFormats = map[string]clockFormat{
+	"da_DK":  {separator: "."},
+	"en_AU":  {twelveHour: true, am: " am", pm: " pm", separator: ":"},
+	"en_CA":  {twelveHour: true, am: " a.m.", pm: " p.m.", separator: ":"},
+	"en_HK":  {twelveHour: true, am: " AM", pm: " PM", separator: ":"},
+	"en_MY":  {twelveHour: true, am: " AM", pm: " PM", separator: ":"},
+	"en_NZ":  {twelveHour: true, am: " am", pm: " pm", separator: ":"},
+	"en_PH":  {twelveHour: true, am: " AM", pm: " PM", separator: ":"},
+	"en_SG":  {twelveHour: true, am: " AM", pm: " PM", separator: ":"},
+	"en_US":  {twelveHour: true, am: " AM", pm: " PM", separator: ":"},
+	"fi_FI":  {separator: "."},
+	"fil_PH": {twelveHour: true, am: " AM", pm: " PM", separator: ":"},
+	"ms_MY":  {twelveHour: true, am: " PG", pm: " PTG", separator: ":"},
+	"zh_HK":  {twelveHour: true, am: "上午", pm: "下午", prefixPeriod: true, separator: ":"},
+	"zh_TW":  {twelveHour: true, am: "上午", pm: "下午", prefixPeriod: true, separator: ":"},
+}
+
+// NewFormatter returns a Formatter for one of Yelp's supported locales.
+func NewFormatter(locale string) (*Formatter, error) {
+	if err := ValidateLocale(locale); err != nil {
+		return nil, err
+	}
+	i := strings.LastIndex(locale, "_")
+	clock, ok := clockFormats[locale]
+	if !ok {
+		clock = clock24
+	}
+	return &Formatter{
+		locale: locale,
+		lang:   languageFormats[locale[:i]],
+		clock:  clock,
+		region: locale[i+1:],
+	}, nil
+}
+
+// Locale returns the locale of f.
+func (f *Formatter) Locale() string {
+	return f.locale
+}
+
+// Weekday returns the name of a day in Yelp's day numbering, where 0 is Monday.
+func (f *Formatter) Weekday(day int) (string, error) {
+	if day < 0 || day > 6 {
+		return "", fmt.Errorf("Invalid day provided: %d", day)
+	}
+	return f.lang.weekdays[day], nil
+}
+
+// TimeOfDay formats a time in Yelp's "HHMM" format, ie. "1730" is "5:30 PM" in
+// en_US and "17:30" in de_DE.
+func (f *Formatter) TimeOfDay(hhmm string) (string, error) {
+	d, err := parseHHMM(hhmm)
+	if err != nil {
+		return "", err
+	}
+	hour, min := int(d.Hours()), int(d.Minutes())%60
+	if !f.clock.twelveHour {
+		return fmt.Sprintf("%02d%s%02d", hour, f.clock.separator, min), nil
+	}
+
+	period := f.clock.am
+	if hour%24 >= 12 {
+		period = f.clock.pm
+	}
+	hour %= 12
+	if hour == 0 {
+		hour = 12
+	}
+	clock := fmt.Sprintf("%d%s%02d", hour, f.clock.separator, min)
+	if f.clock.prefixPeriod {
+		return period + clock, nil
+	}
+	return clock + period, nil
+}
+
+// OpenRange formats the opening and closing times of o, ie. "9:00 AM–5:00 PM".
+func (f *Formatter) OpenRange(o Open) (string, error) {
+	start, err := f.TimeOfDay(o.Start)
+	if err != nil {
+		return "", err
+	}
+	end, err := f.TimeOfDay(o.End)
+	if err != nil {
+		return "", err
+	}
+	return start + "–" + end, nil
+}
+
+// Hours formats the REGULAR hours as one line per day from Monday to Sunday,
+// ie. "Monday: 9:00 AM–2:00 PM, 5:00 PM–10:00 PM" or "Sunday: Closed".
+func (f *Formatter) Hours(hours []Hours) ([]string, error) {
+	var days [7][]string
+	for _, h := range hours {
+		if h.HoursType != HoursTypeRegular {
+			continue
+		}
+		for _, o := range h.Open {
+			if o.Day < 0 || o.Day > 6 {
+				return nil, fmt.Errorf("Invalid day provided: %d", o.Day)
+			}
+			r, err := f.OpenRange(o)
+			if err != nil {
+				return nil, err
+			}
+			days[o.Day] = append(days[o.Day], r)
+		}
+	}
+
+	lines := make([]string, len(days))
+	for day, ranges := range days {
+		text := f.lang.closed
+		if len(ranges) > 0 {
+			text = strings.Join(ranges, ", ")
+		}
+		lines[day] = f.lang.weekdays[day] + ": " + text
+	}
+	return lines, nil
+}
+
+// Price returns the description of a price level, ie. "Moderate" in en_US.
+func (f *Formatter) Price(p PriceLevel) (string, error) {
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+	return f.lang.prices[p-minPriceLevel], nil
+}
+
+// ParsePrice returns the price level of a Business `Price`, which repeats the
+// local currency symbol, ie. "$$" or "€€€".
+func ParsePrice(price string) (PriceLevel, error) {
+	first, _ := utf8.DecodeRuneInString(price)
+	n := utf8.RuneCountInString(price)
+	if n == 0 || strings.Count(price, string(first)) != n {
+		return 0, fmt.Errorf("Invalid price provided: %q", price)
+	}
+	p := PriceLevel(n)
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	return p, nil
+}
+
+// Address formats l as lines laid out for its country. Locations without a
+// country are laid out for the region of the locale of f.
+func (f *Formatter) Address(l Location) []string {
+	country := strings.ToUpper(l.Country)
+	if country == "" {
+		country = f.region
+	}
+	if layout, ok := addressLayouts[country]; ok {
+		return layout(l)
+	}
+	if len(l.DisplayAddress) > 0 {
+		return l.DisplayAddress
+	}
+	return append(streetLines(l), joinNonEmpty(", ", l.City, joinNonEmpty(" ", l.State, l.ZipCode)))
+}
+
+// addressLayouts lay out a Location for each country of validLocales.
+var addressLayouts = map[string]func(Location) []string{
+	"AR": zipCityStateLayout,
+	"AT": zipCityLayout,
+	"AU": cityStateZipLayout(" "),
+	"BE": zipCityLayout,
+	"BR": func(l Location) []string {
+		return appendNonEmpty(streetLines(l), joinNonEmpty(" - ", l.City, l.State), l.ZipCode)
+	},
+	"CA": cityStateZipLayout(", "),
+	"CH": zipCityLayout,
+	"CL": zipCityStateLayout,
+	"CZ": zipCityLayout,
+	"DE": zipCityLayout,
+	"DK": zipCityLayout,
+	"ES": zipCityLayout,
+	"FI": zipCityLayout,
+	"FR": zipCityLayout,
+	"GB": cityZipLinesLayout,
+	"HK": func(l Location) []string {
+		return appendNonEmpty(streetLines(l), l.City)
+	},
+	"IE": cityZipLinesLayout,
+	"IT": func(l Location) []string {
+		return appendNonEmpty(streetLines(l), joinNonEmpty(" ", l.ZipCode, l.City, l.State))
+	},
+	"JP": func(l Location) []string {
+		zip := l.ZipCode
+		if zip != "" {
+			zip = "〒" + zip
+		}
+		return appendNonEmpty(nil, zip, l.State+l.City+l.Address1+l.Address2+l.Address3)
+	},
+	"MX": zipCityStateLayout,
+	"MY": zipCityStateLayout,
+	"NL": zipCityLayout,
+	"NO": zipCityLayout,
+	"NZ": cityZipLayout,
+	"PH": cityZipLayout,
+	"PL": zipCityLayout,
+	"PT": zipCityLayout,
+	"SE": zipCityLayout,
+	"SG": cityZipLayout,
+	"TR": func(l Location) []string {
+		return appendNonEmpty(streetLines(l), joinNonEmpty(" ", l.ZipCode, joinNonEmpty("/", l.City, l.State)))
+	},
+	"TW": func(l Location) []string {
+		return []string{l.ZipCode + l.State + l.City + l.Address1 + l.Address2 + l.Address3}
+	},
+	"US": cityStateZipLayout(", "),
+}
+
+// cityStateZipLayout ends an address with "City, ST 12345", separating the city
+// and state by sep.
+func cityStateZipLayout(sep string) func(Location) []string {
+	return func(l Location) []string {
+		return appendNonEmpty(streetLines(l), joinNonEmpty(sep, l.City, joinNonEmpty(" ", l.State, l.ZipCode)))
+	}
+}
+
+// zipCityLayout ends an address with "12345 City".
+func zipCityLayout(l Location) []string {
+	return appendNonEmpty(streetLines(l), joinNonEmpty(" ", l.ZipCode, l.City))
+}
+
+// zipCityStateLayout ends an address with "12345 City, State".
+func zipCityStateLayout(l Location) []string {
+	return appendNonEmpty(streetLines(l), joinNonEmpty(", ", joinNonEmpty(" ", l.ZipCode, l.City), l.State))
+}
+
+// cityZipLayout ends an address with "City 12345".
+func cityZipLayout(l Location) []string {
+	return appendNonEmpty(streetLines(l), joinNonEmpty(" ", l.City, l.ZipCode))
+}
+
+// cityZipLinesLayout ends an address with the city and postcode on their own
+// lines.
+func cityZipLinesLayout(l Location) []string {
+	return appendNonEmpty(streetLines(l), l.City, l.ZipCode)
+}
+
+// streetLines returns the non-empty street address lines of l.
+func streetLines(l Location) []string {
+	return appendNonEmpty(nil, l.Address1, l.Address2, l.Address3)
+}
+
+// appendNonEmpty appends the non-empty strs to lines.
+func appendNonEmpty(lines []string, strs ...string) []string {
+	for _, s := range strs {
+		if s != "" {
+			lines = append(lines, s)
+		}
+	}
+	return lines
+}
+
+// joinNonEmpty joins the non-empty strs with sep.
+func joinNonEmpty(sep string, strs ...string) string {
+	return strings.Join(appendNonEmpty(nil, strs...), sep)
+}
@@ -0,0 +1,97 @@
+package yelp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewFormatter(t *testing.T) {
+	for locale := range validLocales {
+		f, err := NewFormatter(locale)
+		assert(t, err == nil, "Expected a formatter for %s (%v)", locale, err)
+		day, err := f.Weekday(6)
+		assert(t, err == nil && day != "", "Expected a weekday name for %s (%v)", locale, err)
+	}
+
+	_, err := NewFormatter("en_XX")
+	assert(t, err != nil, "Expected an unsupported locale to error")
+}
+
+func TestFormatterHours(t *testing.T) {
+	hours := []Hours{{
+		HoursType: HoursTypeRegular,
+		Open: []Open{
+			{Day: 0, Start: "0900", End: "1400"},
+			{Day: 0, Start: "1700", End: "2230"},
+			{Day: 4, Start: "2200", End: "0200", IsOvernight: true},
+		},
+	}}
+
+	for _, tc := range []struct {
+		locale string
+		monday string
+		friday string
+		sunday string
+	}{
+		{"en_US", "Monday: 9:00 AM–2:00 PM, 5:00 PM–10:30 PM", "Friday: 10:00 PM–2:00 AM", "Sunday: Closed"},
+		{"de_DE", "Montag: 09:00–14:00, 17:00–22:30", "Freitag: 22:00–02:00", "Sonntag: Geschlossen"},
+		{"fi_FI", "maanantai: 09.00–14.00, 17.00–22.30", "perjantai: 22.00–02.00", "sunnuntai: Suljettu"},
+		{"zh_TW", "星期一: 上午9:00–下午2:00, 下午5:00–下午10:30", "星期五: 下午10:00–上午2:00", "星期日: 休息"},
+	} {
+		f, _ := NewFormatter(tc.locale)
+		lines, err := f.Hours(hours)
+		assert(t, err == nil && len(lines) == 7, "Expected 7 lines for %s, got %v (%v)", tc.locale, lines, err)
+		assert(t, lines[0] == tc.monday, "Expected %q for %s, got %q", tc.monday, tc.locale, lines[0])
+		assert(t, lines[4] == tc.friday, "Expected %q for %s, got %q", tc.friday, tc.locale, lines[4])
+		assert(t, lines[6] == tc.sunday, "Expected %q for %s, got %q", tc.sunday, tc.locale, lines[6])
+	}
+
+	f, _ := NewFormatter("en_US")
+	midnight, _ := f.TimeOfDay("0000")
+	assert(t, midnight == "12:00 AM", "Expected midnight to be 12:00 AM, got %s", midnight)
+	_, err := f.Hours([]Hours{{HoursType: HoursTypeRegular, Open: []Open{{Day: 7, Start: "0900", End: "1700"}}}})
+	assert(t, err != nil, "Expected an invalid day to error")
+}
+
+func TestFormatterAddress(t *testing.T) {
+	for _, tc := range []struct {
+		locale   string
+		location Location
+		address  string
+	}{
+		{"en_US", Location{Address1: "1 Oak Lab", City: "Pallet Town", State: "KT", ZipCode: "00001", Country: "US"}, "1 Oak Lab|Pallet Town, KT 00001"},
+		{"en_US", Location{Address1: "Friedrichstr. 1", City: "Berlin", ZipCode: "10117", Country: "DE"}, "Friedrichstr. 1|10117 Berlin"},
+		{"de_DE", Location{Address1: "Friedrichstr. 1", City: "Berlin", ZipCode: "10117"}, "Friedrichstr. 1|10117 Berlin"},
+		{"en_GB", Location{Address1: "221B Baker St", City: "London", ZipCode: "NW1 6XE", Country: "GB"}, "221B Baker St|London|NW1 6XE"},
+		{"ja_JP", Location{Address1: "丸の内1-1", City: "千代田区", State: "東京都", ZipCode: "100-0005", Country: "JP"}, "〒100-0005|東京都千代田区丸の内1-1"},
+		{"pt_BR", Location{Address1: "Av. Paulista, 1000", City: "São Paulo", State: "SP", ZipCode: "01310-100", Country: "BR"}, "Av. Paulista, 1000|São Paulo - SP|01310-100"},
+		{"en_US", Location{City: "Reykjavík", Country: "IS", DisplayAddress: []string{"Laugavegur 1", "101 Reykjavík"}}, "Laugavegur 1|101 Reykjavík"},
+	} {
+		f, _ := NewFormatter(tc.locale)
+		address := strings.Join(f.Address(tc.location), "|")
+		assert(t, address == tc.address, "Expected %q for %s, got %q", tc.address, tc.locale, address)
+	}
+}
+
+func TestFormatterPrice(t *testing.T) {
+	for _, tc := range []struct {
+		locale      string
+		price       string
+		description string
+	}{
+		{"en_US", "$$", "Moderate"},
+		{"fr_FR", "€€€€", "Très cher"},
+		{"ja_JP", "￥", "安い"},
+	} {
+		f, _ := NewFormatter(tc.locale)
+		p, err := ParsePrice(tc.price)
+		assert(t, err == nil, "Expected %q to parse (%v)", tc.price, err)
+		description, err := f.Price(p)
+		assert(t, err == nil && description == tc.description, "Expected %q for %s, got %q (%v)", tc.description, tc.locale, description, err)
+	}
+
+	for _, price := range []string{"", "$€", "$$$$$"} {
+		_, err := ParsePrice(price)
+		assert(t, err != nil, "Expected %q to error", price)
+	}
+}
@@ -0,0 +1,165 @@
+package yelp
+
+import "math"
+
+// earthRadius is the mean radius of the Earth in meters.
+const earthRadius = 6371008.8
+
+// DistanceTo returns the great-circle distance in meters from c to o, using the
+// haversine formula.
+func (c Coordinates) DistanceTo(o Coordinates) float64 {
+	lat1, lat2 := radians(c.Latitude), radians(o.Latitude)
+	dLat := lat2 - lat1
+	dLng := radians(o.Longitude - c.Longitude)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// BearingTo returns the initial bearing in degrees, clockwise from north, of the
+// great-circle path from c to o.
+func (c Coordinates) BearingTo(o Coordinates) float64 {
+	lat1, lat2 := radians(c.Latitude), radians(o.Latitude)
+	dLng := radians(o.Longitude - c.Longitude)
+
+	y := math.Sin(dLng) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLng)
+	return math.Mod(degrees(math.Atan2(y, x))+360, 360)
+}
+
+// Destination returns the point reached by travelling distance meters from c
+// along the great-circle path with the given initial bearing in degrees.
+func (c Coordinates) Destination(bearing float64, distance float64) Coordinates {
+	lat1, lng1 := radians(c.Latitude), radians(c.Longitude)
+	theta := radians(bearing)
+	delta := distance / earthRadius
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(delta) + math.Cos(lat1)*math.Sin(delta)*math.Cos(theta))
+	lng2 := lng1 + math.Atan2(math.Sin(theta)*math.Sin(delta)*math.Cos(lat1), math.Cos(delta)-math.Sin(lat1)*math.Sin(lat2))
+	return Coordinates{
+		Latitude:  degrees(lat2),
+		Longitude: normalizeLongitude(degrees(lng2)),
+	}
+}
+
+// BoundingBox is an area between two corners. A box whose SouthWest longitude is
+// greater than its NorthEast longitude crosses the antimeridian.
+type BoundingBox struct {
+	SouthWest Coordinates `json:"south_west"`
+	NorthEast Coordinates `json:"north_east"`
+}
+
+// NewBoundingBox returns the smallest BoundingBox containing the circle of
+// radius meters around center.
+func NewBoundingBox(center Coordinates, radius float64) BoundingBox {
+	dLat := degrees(radius / earthRadius)
+	south, north := center.Latitude-dLat, center.Latitude+dLat
+	if south <= -90 || north >= 90 {
+		// the circle contains a pole, so it spans every longitude
+		return BoundingBox{
+			SouthWest: Coordinates{Latitude: math.Max(south, -90), Longitude: -180},
+			NorthEast: Coordinates{Latitude: math.Min(north, 90), Longitude: 180},
+		}
+	}
+
+	dLng := degrees(math.Asin(math.Min(1, math.Sin(radius/earthRadius)/math.Cos(radians(center.Latitude)))))
+	if dLng >= 180 {
+		return BoundingBox{
+			SouthWest: Coordinates{Latitude: south, Longitude: -180},
+			NorthEast: Coordinates{Latitude: north, Longitude: 180},
+		}
+	}
+	return BoundingBox{
+		SouthWest: Coordinates{Latitude: south, Longitude: normalizeLongitude(center.Longitude - dLng)},
+		NorthEast: Coordinates{Latitude: north, Longitude: normalizeLongitude(center.Longitude + dLng)},
+	}
+}
+
+// Contains returns whether c is inside b.
+func (b BoundingBox) Contains(c Coordinates) bool {
+	if c.Latitude < b.SouthWest.Latitude || c.Latitude > b.NorthEast.Latitude {
+		return false
+	}
+	return b.containsLongitude(c.Longitude)
+}
+
+// Intersects returns whether b and o share any area.
+func (b BoundingBox) Intersects(o BoundingBox) bool {
+	if o.NorthEast.Latitude < b.SouthWest.Latitude || o.SouthWest.Latitude > b.NorthEast.Latitude {
+		return false
+	}
+	return b.containsLongitude(o.SouthWest.Longitude) || o.containsLongitude(b.SouthWest.Longitude)
+}
+
+// Expand returns the smallest BoundingBox containing both b and c.
+func (b BoundingBox) Expand(c Coordinates) BoundingBox {
+	b.SouthWest.Latitude = math.Min(b.SouthWest.Latitude, c.Latitude)
+	b.NorthEast.Latitude = math.Max(b.NorthEast.Latitude, c.Latitude)
+	if b.containsLongitude(c.Longitude) {
+		return b
+	}
+
+	// grow towards whichever side of the box is closer to c
+	west := math.Mod(b.SouthWest.Longitude-c.Longitude+360, 360)
+	east := math.Mod(c.Longitude-b.NorthEast.Longitude+360, 360)
+	if west < east {
+		b.SouthWest.Longitude = c.Longitude
+	} else {
+		b.NorthEast.Longitude = c.Longitude
+	}
+	return b
+}
+
+// Center returns the center point of b.
+func (b BoundingBox) Center() Coordinates {
+	return Coordinates{
+		Latitude:  (b.SouthWest.Latitude + b.NorthEast.Latitude) / 2,
+		Longitude: normalizeLongitude(b.SouthWest.Longitude + b.longitudeSpan()/2),
+	}
+}
+
+// SearchArea returns the center of b and the radius in meters of the smallest
+// circle around it that covers b, as used by BusinessSearchOptions `Coordinates`
+// and `Radius`. The radius is not capped to the limit of the Business Search API.
+func (b BoundingBox) SearchArea() (Coordinates, int64) {
+	center := b.Center()
+	var radius float64
+	for _, corner := range []Coordinates{
+		b.SouthWest,
+		b.NorthEast,
+		{Latitude: b.SouthWest.Latitude, Longitude: b.NorthEast.Longitude},
+		{Latitude: b.NorthEast.Latitude, Longitude: b.SouthWest.Longitude},
+	} {
+		radius = math.Max(radius, center.DistanceTo(corner))
+	}
+	return center, int64(math.Ceil(radius))
+}
+
+// containsLongitude returns whether lng is within the longitudes of b.
+func (b BoundingBox) containsLongitude(lng float64) bool {
+	return math.Mod(lng-b.SouthWest.Longitude+360, 360) <= b.longitudeSpan()
+}
+
+// longitudeSpan returns the degrees of longitude covered by b.
+func (b BoundingBox) longitudeSpan() float64 {
+	span := b.NorthEast.Longitude - b.SouthWest.Longitude
+	if span < 0 {
+		span += 360
+	}
+	return span
+}
+
+// normalizeLongitude wraps lng into the range [-180, 180).
+func normalizeLongitude(lng float64) float64 {
+	return math.Mod(math.Mod(lng+180, 360)+360, 360) - 180
+}
+
+// radians converts degrees to radians.
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// degrees converts radians to degrees.
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
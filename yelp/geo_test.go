@@ -0,0 +1,91 @@
+package yelp
+
+import (
+	"math"
+	"testing"
+)
+
+func near(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+func TestCoordinates(t *testing.T) {
+	sf := Coordinates{Latitude: 37.7749, Longitude: -122.4194}
+	nyc := Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+
+	t.Run("DistanceTo", func(t *testing.T) {
+		d := sf.DistanceTo(nyc)
+		assert(t, near(d, 4129000, 2000), "Expected distance (%f) to be about 4129km", d)
+		assert(t, sf.DistanceTo(sf) == 0, "Expected no distance to itself")
+	})
+
+	t.Run("BearingTo", func(t *testing.T) {
+		b := sf.BearingTo(nyc)
+		assert(t, near(b, 69.9, 0.5), "Expected bearing (%f) to be about 69.9", b)
+
+		north := Coordinates{Latitude: 1}.BearingTo(Coordinates{Latitude: 2})
+		assert(t, near(north, 0, 1e-9), "Expected bearing (%f) to be north", north)
+	})
+
+	t.Run("Destination", func(t *testing.T) {
+		dest := sf.Destination(sf.BearingTo(nyc), sf.DistanceTo(nyc))
+		assert(t, near(dest.Latitude, nyc.Latitude, 1e-6) && near(dest.Longitude, nyc.Longitude, 1e-6), "Expected destination (%v) to be %v", dest, nyc)
+
+		wrapped := Coordinates{Longitude: 179.9}.Destination(90, 50000)
+		assert(t, wrapped.Longitude < -179, "Expected destination longitude (%f) to wrap around", wrapped.Longitude)
+	})
+}
+
+func TestBoundingBox(t *testing.T) {
+	center := Coordinates{Latitude: 40.7128, Longitude: -74.0060}
+	box := NewBoundingBox(center, 1000)
+
+	t.Run("NewBoundingBox", func(t *testing.T) {
+		for _, bearing := range []float64{0, 90, 180, 270} {
+			p := center.Destination(bearing, 999)
+			assert(t, box.Contains(p), "Expected %v to be contained in %v", p, box)
+		}
+		assert(t, !box.Contains(center.Destination(45, 1500)), "Expected point outside the box to not be contained")
+
+		polar := NewBoundingBox(Coordinates{Latitude: 89.99}, 5000)
+		assert(t, polar.NorthEast.Latitude == 90 && polar.Contains(Coordinates{Latitude: 89.995, Longitude: 100}), "Expected box around the pole (%v) to span every longitude", polar)
+	})
+
+	t.Run("Antimeridian", func(t *testing.T) {
+		fiji := BoundingBox{
+			SouthWest: Coordinates{Latitude: -20, Longitude: 175},
+			NorthEast: Coordinates{Latitude: -15, Longitude: -178},
+		}
+		assert(t, fiji.Contains(Coordinates{Latitude: -17, Longitude: 179}), "Expected point west of the antimeridian to be contained")
+		assert(t, fiji.Contains(Coordinates{Latitude: -17, Longitude: -179}), "Expected point east of the antimeridian to be contained")
+		assert(t, !fiji.Contains(Coordinates{Latitude: -17, Longitude: 0}), "Expected point far away to not be contained")
+
+		c := fiji.Center()
+		assert(t, near(c.Longitude, 178.5, 1e-9), "Expected center longitude (%f) to be 178.5", c.Longitude)
+	})
+
+	t.Run("Intersects", func(t *testing.T) {
+		other := NewBoundingBox(center.Destination(90, 1500), 1000)
+		far := NewBoundingBox(center.Destination(90, 5000), 1000)
+		assert(t, box.Intersects(other) && other.Intersects(box), "Expected overlapping boxes to intersect")
+		assert(t, !box.Intersects(far) && !far.Intersects(box), "Expected distant boxes to not intersect")
+	})
+
+	t.Run("Expand", func(t *testing.T) {
+		p := center.Destination(0, 5000)
+		expanded := box.Expand(p)
+		assert(t, expanded.Contains(p) && expanded.Contains(center), "Expected expanded box (%v) to contain %v", expanded, p)
+
+		dateline := BoundingBox{
+			SouthWest: Coordinates{Latitude: 0, Longitude: 170},
+			NorthEast: Coordinates{Latitude: 1, Longitude: 179},
+		}.Expand(Coordinates{Latitude: 0, Longitude: -179})
+		assert(t, dateline.SouthWest.Longitude == 170 && dateline.NorthEast.Longitude == -179, "Expected box (%v) to grow across the antimeridian", dateline)
+	})
+
+	t.Run("SearchArea", func(t *testing.T) {
+		c, radius := box.SearchArea()
+		assert(t, near(c.Latitude, center.Latitude, 1e-9) && near(c.Longitude, center.Longitude, 1e-9), "Expected center (%v) to be %v", c, center)
+		assert(t, radius >= 1000 && radius <= 1415, "Expected radius (%d) to cover the box corners", radius)
+	})
+}
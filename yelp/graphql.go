@@ -0,0 +1,224 @@
+package yelp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// graphQLPath is the path of the GraphQL API.
+const graphQLPath = "/v3/graphql"
+
+// defaultGraphQLBatchSize is the number of businesses requested by a GraphQL
+// batch when no maximum is given.
+const defaultGraphQLBatchSize = 50
+
+// graphQLBusinessFields is the fragment of the Business fields requested from
+// the GraphQL API, which are named like the Business JSON fields. Fields named
+// differently in the GraphQL schema are aliased to their JSON name.
+const graphQLBusinessFields = `fragment businessFields on Business {
+  id alias name url phone display_phone review_count rating price is_claimed is_closed photos
+  categories { title alias }
+  coordinates { latitude longitude }
+  location { address1 address2 address3 city state zip_code: postal_code country }
+  hours { hours_type is_open_now open { day start end is_overnight } }
+  special_hours { date start end is_closed is_overnight }
+}`
+
+// WithGraphQLBatching makes GetBusiness calls that are made within window of
+// each other be sent together as a single GraphQL request for up to maxBatch
+// businesses, so that they use a single request of quota. Calls are only
+// batched with calls using the same context API key and locale.
+func WithGraphQLBatching(window time.Duration, maxBatch int) Option {
+	return func(c *client) {
+		if maxBatch <= 0 {
+			maxBatch = defaultGraphQLBatchSize
+		}
+		c.batcher = &graphQLBatcher{
+			c:        c,
+			window:   window,
+			maxBatch: maxBatch,
+			pending:  map[batchKey]*graphQLBatch{},
+		}
+	}
+}
+
+// graphQLBatcher collects GetBusiness calls into GraphQL batches.
+type graphQLBatcher struct {
+	c        *client
+	window   time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	pending map[batchKey]*graphQLBatch
+}
+
+// batchKey identifies the calls which can share a batch.
+type batchKey struct {
+	apiKey    string
+	hasAPIKey bool
+	locale    string
+}
+
+// graphQLBatch is a set of business IDs requested together.
+type graphQLBatch struct {
+	key   batchKey
+	ids   []string
+	timer *time.Timer
+
+	// waiters are the result channels of the calls for each ID.
+	waiters map[string][]chan<- graphQLResult
+}
+
+// graphQLResult is the result of a single business of a batch.
+type graphQLResult struct {
+	business *Business
+	err      error
+}
+
+// graphQLRequest is the body of a GraphQL request.
+type graphQLRequest struct {
+	Query string `json:"query"`
+}
+
+// graphQLResponse is the body of a GraphQL response for a batch, keyed by alias.
+type graphQLResponse struct {
+	Data   map[string]*Business `json:"data"`
+	Errors []graphQLError       `json:"errors"`
+}
+
+// graphQLError is an error returned by the GraphQL API. Path starts with the
+// alias of the business it is for, if any.
+type graphQLError struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path"`
+}
+
+// getBusiness adds the business of gbo to a batch, and waits for its result.
+func (b *graphQLBatcher) getBusiness(ctx context.Context, gbo *GetBusinessOptions) (*Business, error) {
+	key := batchKey{locale: StringValue(gbo.Locale)}
+	key.apiKey, key.hasAPIKey = APIKeyFromContext(ctx)
+	if locale, ok := LocaleFromContext(ctx); ok && gbo.Locale == nil {
+		if err := ValidateLocale(locale); err != nil {
+			return nil, fmt.Errorf("context locale is invalid: %v", err)
+		}
+		key.locale = locale
+	}
+
+	result := make(chan graphQLResult, 1)
+	b.mu.Lock()
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &graphQLBatch{key: key, waiters: map[string][]chan<- graphQLResult{}}
+		b.pending[key] = batch
+		batch.timer = time.AfterFunc(b.window, func() {
+			b.flush(batch)
+		})
+	}
+	if _, ok := batch.waiters[gbo.ID]; !ok {
+		batch.ids = append(batch.ids, gbo.ID)
+	}
+	batch.waiters[gbo.ID] = append(batch.waiters[gbo.ID], result)
+	if len(batch.ids) >= b.maxBatch {
+		batch.timer.Stop()
+		delete(b.pending, key)
+		go b.send(batch)
+	}
+	b.mu.Unlock()
+
+	select {
+	case r := <-result:
+		return r.business, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// flush sends batch if it is still pending.
+func (b *graphQLBatcher) flush(batch *graphQLBatch) {
+	b.mu.Lock()
+	if b.pending[batch.key] != batch {
+		b.mu.Unlock()
+		return
+	}
+	delete(b.pending, batch.key)
+	b.mu.Unlock()
+	b.send(batch)
+}
+
+// send makes the GraphQL request of batch and sends each business to its
+// waiters. The request is not cancelled by the callers, since others may still
+// be waiting on it.
+func (b *graphQLBatcher) send(batch *graphQLBatch) {
+	ctx := context.Background()
+	if batch.key.hasAPIKey {
+		ctx = WithAPIKey(ctx, batch.key.apiKey)
+	}
+	headers := map[string]string{"Content-Type": "application/json"}
+	if batch.key.locale != "" {
+		headers["Accept-Language"] = batch.key.locale
+	}
+
+	body, err := json.Marshal(graphQLRequest{Query: graphQLBatchQuery(batch.ids)})
+	var resp graphQLResponse
+	if err == nil {
+		_, err = b.c.authedDo(ctx, http.MethodPost, graphQLPath, bytes.NewReader(body), headers, &resp)
+	}
+
+	// errors without a path apply to the whole batch
+	errs := map[string]string{}
+	for _, e := range resp.Errors {
+		if len(e.Path) == 0 {
+			if err == nil {
+				err = fmt.Errorf("GraphQL error: %s", e.Message)
+			}
+			continue
+		}
+		if alias, ok := e.Path[0].(string); ok {
+			errs[alias] = e.Message
+		}
+	}
+
+	for i, id := range batch.ids {
+		alias := graphQLAlias(i)
+		var r graphQLResult
+		switch {
+		case err != nil:
+			r.err = err
+		case errs[alias] != "":
+			r.err = fmt.Errorf("GraphQL error for business %s: %s", id, errs[alias])
+		case resp.Data[alias] == nil:
+			r.err = fmt.Errorf("Business not found: %s", id)
+		default:
+			r.business = resp.Data[alias]
+		}
+		for _, w := range batch.waiters[id] {
+			w <- r
+		}
+	}
+}
+
+// graphQLBatchQuery returns a GraphQL query for the businesses of ids, aliased
+// as "b0", "b1" and so on.
+func graphQLBatchQuery(ids []string) string {
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	for i, id := range ids {
+		// JSON strings are valid GraphQL strings
+		quoted, _ := json.Marshal(id)
+		fmt.Fprintf(&sb, "  %s: business(id: %s) { ...businessFields }\n", graphQLAlias(i), quoted)
+	}
+	sb.WriteString("}\n")
+	sb.WriteString(graphQLBusinessFields)
+	return sb.String()
+}
+
+// graphQLAlias returns the alias of the business at index i of a batch.
+func graphQLAlias(i int) string {
+	return fmt.Sprintf("b%d", i)
+}
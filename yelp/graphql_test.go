@@ -0,0 +1,189 @@
+package yelp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// graphQLSchema is the allow-list of the fields of the GraphQL types requested
+// by graphQLBusinessFields, mapped to the type of object fields.
+var graphQLSchema = map[string]map[string]string{
+	"Business": {
+		"id": "", "alias": "", "name": "", "url": "", "phone": "", "display_phone": "",
+		"review_count": "", "rating": "", "price": "", "is_claimed": "", "is_closed": "", "photos": "",
+		"categories": "Category", "coordinates": "Coordinates", "location": "Location",
+		"hours": "Hours", "special_hours": "SpecialHours",
+	},
+	"Category":     {"title": "", "alias": ""},
+	"Coordinates":  {"latitude": "", "longitude": ""},
+	"Location":     {"address1": "", "address2": "", "address3": "", "city": "", "state": "", "postal_code": "", "country": "", "formatted_address": ""},
+	"Hours":        {"hours_type": "", "is_open_now": "", "open": "OpenHours"},
+	"OpenHours":    {"day": "", "start": "", "end": "", "is_overnight": ""},
+	"SpecialHours": {"date": "", "start": "", "end": "", "is_closed": "", "is_overnight": ""},
+}
+
+// validateGraphQLFragment returns an error for the first field of the
+// businessFields fragment of query which is not in graphQLSchema.
+func validateGraphQLFragment(query string) error {
+	const prefix = "fragment businessFields on Business {"
+	i := strings.Index(query, prefix)
+	if i < 0 {
+		return fmt.Errorf("missing businessFields fragment")
+	}
+	body := strings.NewReplacer("{", " { ", "}", " } ").Replace(query[i+len(prefix):])
+	types := []string{"Business"}
+	last := ""
+	for _, token := range strings.Fields(body) {
+		switch {
+		case token == "{":
+			child := graphQLSchema[types[len(types)-1]][last]
+			if child == "" {
+				return fmt.Errorf("field %q has no selections", last)
+			}
+			types = append(types, child)
+		case token == "}":
+			types = types[:len(types)-1]
+			if len(types) == 0 {
+				return nil
+			}
+		case strings.HasSuffix(token, ":"):
+			// an alias of the next field
+		default:
+			typ := types[len(types)-1]
+			if _, ok := graphQLSchema[typ][token]; !ok {
+				return fmt.Errorf("cannot query field %q on type %q", token, typ)
+			}
+			last = token
+		}
+	}
+	return fmt.Errorf("unterminated businessFields fragment")
+}
+
+func TestGraphQLBatching(t *testing.T) {
+	names := map[string]string{"brock": "Brock", "misty": "Misty", "surge": "Surge", "erika": "Erika"}
+	aliasPattern := regexp.MustCompile(`(b\d+): business\(id: "([^"]*)"\)`)
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		var req graphQLRequest
+		if r.Method != http.MethodPost || r.URL.Path != graphQLPath || json.NewDecoder(r.Body).Decode(&req) != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		if err := validateGraphQLFragment(req.Query); err != nil {
+			json.NewEncoder(w).Encode(map[string]interface{}{"errors": []graphQLError{{Message: err.Error()}}})
+			return
+		}
+
+		data := map[string]interface{}{}
+		var errs []graphQLError
+		for _, m := range aliasPattern.FindAllStringSubmatch(req.Query, -1) {
+			alias, id := m[1], m[2]
+			switch id {
+			case "missingno":
+				data[alias] = nil
+			case "glitch":
+				data[alias] = nil
+				errs = append(errs, graphQLError{Message: "BUSINESS_UNAVAILABLE", Path: []interface{}{alias}})
+			default:
+				data[alias] = map[string]interface{}{
+					"id":         id,
+					"name":       names[id],
+					"rating":     4.5,
+					"categories": []map[string]string{{"alias": "gyms", "title": "Gyms"}},
+					"location":   map[string]string{"city": r.Header.Get("Accept-Language"), "zip_code": "00001"},
+				}
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data, "errors": errs})
+	}))
+	defer server.Close()
+
+	newClient := func(window time.Duration, maxBatch int) Client {
+		return New(server.Client(), "api-key", WithGraphQLBatching(window, maxBatch), func(c *client) { c.host = server.URL })
+	}
+
+	t.Run("Concurrent calls share a request", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		c := newClient(time.Hour, 3)
+		ids := []string{"brock", "misty", "surge", "erika", "missingno", "glitch"}
+		businesses := make([]*Business, len(ids))
+		errs := make([]error, len(ids))
+		var wg sync.WaitGroup
+		for i, id := range ids {
+			wg.Add(1)
+			go func(i int, id string) {
+				defer wg.Done()
+				businesses[i], errs[i] = c.GetBusiness(context.Background(), &GetBusinessOptions{ID: id})
+			}(i, id)
+		}
+		wg.Wait()
+
+		assert(t, atomic.LoadInt32(&requests) == 2, "Expected 2 GraphQL requests, got %d", requests)
+		for i, id := range ids[:4] {
+			assert(t, errs[i] == nil && businesses[i].ID == id && businesses[i].Name == names[id], "Unexpected result for %s: %+v (%v)", id, businesses[i], errs[i])
+		}
+		assert(t, businesses[0].Categories[0].Alias == "gyms" && businesses[0].Rating == 4.5 && businesses[0].Location.ZipCode == "00001", "Expected the business to be decoded, got %+v", businesses[0])
+		assert(t, errs[4] != nil && strings.Contains(errs[4].Error(), "not found"), "Expected missingno to not be found (%v)", errs[4])
+		assert(t, errs[5] != nil && strings.Contains(errs[5].Error(), "BUSINESS_UNAVAILABLE"), "Expected the GraphQL error for glitch (%v)", errs[5])
+	})
+
+	t.Run("Duplicate IDs share an alias", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		c := newClient(50*time.Millisecond, 0)
+		businesses := make([]*Business, 3)
+		errs := make([]error, 3)
+		var wg sync.WaitGroup
+		for i := range businesses {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				businesses[i], errs[i] = c.GetBusiness(context.Background(), &GetBusinessOptions{ID: "blaine"})
+			}(i)
+		}
+		wg.Wait()
+		for i, b := range businesses {
+			assert(t, errs[i] == nil && b.ID == "blaine", "Unexpected result %+v (%v)", b, errs[i])
+		}
+		assert(t, atomic.LoadInt32(&requests) == 1, "Expected 1 GraphQL request, got %d", requests)
+	})
+
+	t.Run("Batches are sent after the window", func(t *testing.T) {
+		atomic.StoreInt32(&requests, 0)
+		c := newClient(10*time.Millisecond, 0)
+		b, err := c.GetBusiness(context.Background(), &GetBusinessOptions{ID: "erika", Locale: StringPointer("ja_JP")})
+		assert(t, err == nil && b.ID == "erika" && b.Location.City == "ja_JP", "Expected the locale to be sent, got %+v (%v)", b, err)
+
+		ctx := WithLocale(context.Background(), "fr_FR")
+		b, err = c.GetBusiness(ctx, &GetBusinessOptions{ID: "sabrina"})
+		assert(t, err == nil && b.Location.City == "fr_FR", "Expected the context locale to be sent, got %+v (%v)", b, err)
+		assert(t, atomic.LoadInt32(&requests) == 2, "Expected 2 GraphQL requests, got %d", requests)
+	})
+
+	t.Run("Callers can stop waiting", func(t *testing.T) {
+		c := newClient(time.Hour, 0)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := c.GetBusiness(ctx, &GetBusinessOptions{ID: "koga"})
+		assert(t, err == context.DeadlineExceeded, "Expected the deadline error, got %v", err)
+	})
+}
+
+func TestGraphQLBatchQuery(t *testing.T) {
+	query := graphQLBatchQuery([]string{"pallet-town", `"quoted"`})
+	assert(t, strings.Contains(query, `b0: business(id: "pallet-town") { ...businessFields }`), "Unexpected query %s", query)
+	assert(t, strings.Contains(query, fmt.Sprintf(`b1: business(id: %s)`, `"\"quoted\""`)), "Expected IDs to be escaped in %s", query)
+	assert(t, strings.HasSuffix(query, graphQLBusinessFields), "Expected the business fragment in %s", query)
+	assert(t, validateGraphQLFragment(query) == nil, "Expected only schema fields to be queried: %v", validateGraphQLFragment(query))
+	invalid := strings.Replace(query, "zip_code: postal_code", "zip_code", 1)
+	assert(t, validateGraphQLFragment(invalid) != nil, "Expected zip_code to not be a Location field")
+}
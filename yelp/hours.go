@@ -0,0 +1,256 @@
+package yelp
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// HoursTypeRegular is the HoursType of a business's regular opening hours.
+const HoursTypeRegular = "REGULAR"
+
+// dateLayout is the format of the dates of SpecialHours.
+const dateLayout = "2006-01-02"
+
+// scheduleWindow is how many days past a given time a Schedule looks ahead when
+// finding the next opening or closing time.
+const scheduleWindow = 14
+
+// Schedule is a weekly opening schedule built from a business's Hours, evaluated
+// in the business's timezone.
+type Schedule struct {
+	loc *time.Location
+
+	// days contains the opening spans for each day of the week, indexed by Yelp's
+	// day numbering (0 is Monday).
+	days [7][]daySpan
+
+	// special contains the opening spans for dates with special hours, keyed by
+	// date in "2006-01-02" format. Dates the business is closed have no spans.
+	special map[string][]daySpan
+}
+
+// daySpan is an opening span as offsets from midnight of the day it starts on.
+// Overnight spans end more than 24 hours after that midnight.
+type daySpan struct {
+	start time.Duration
+	end   time.Duration
+}
+
+// interval is an opening span at concrete times.
+type interval struct {
+	start time.Time
+	end   time.Time
+}
+
+// NewSchedule merges every Hours entry of the given hoursType into a weekly
+// Schedule for the given timezone. An empty hoursType merges REGULAR hours. If
+// loc is nil, times are evaluated in their own location.
+func NewSchedule(hours []Hours, hoursType string, loc *time.Location) (*Schedule, error) {
+	if hoursType == "" {
+		hoursType = HoursTypeRegular
+	}
+
+	s := &Schedule{loc: loc, special: map[string][]daySpan{}}
+	for _, h := range hours {
+		if h.HoursType != hoursType {
+			continue
+		}
+		for _, o := range h.Open {
+			span, err := o.span()
+			if err != nil {
+				return nil, err
+			}
+			s.days[o.Day] = append(s.days[o.Day], span)
+		}
+	}
+	return s, nil
+}
+
+// SchedulesByType returns a Schedule for each HoursType present in hours.
+func SchedulesByType(hours []Hours, loc *time.Location) (map[string]*Schedule, error) {
+	schedules := map[string]*Schedule{}
+	for _, h := range hours {
+		if _, ok := schedules[h.HoursType]; ok {
+			continue
+		}
+		s, err := NewSchedule(hours, h.HoursType, loc)
+		if err != nil {
+			return nil, err
+		}
+		schedules[h.HoursType] = s
+	}
+	return schedules, nil
+}
+
+// AddSpecialHours overrides the schedule on the dates of the special hours.
+func (s *Schedule) AddSpecialHours(special []SpecialHours) error {
+	for _, sh := range special {
+		if _, err := time.Parse(dateLayout, sh.Date); err != nil {
+			return fmt.Errorf("Invalid date provided: %q", sh.Date)
+		}
+		spans, ok := s.special[sh.Date]
+		if !ok {
+			spans = []daySpan{}
+		}
+		if !sh.IsClosed {
+			span, err := Open{Start: sh.Start, End: sh.End, IsOvernight: sh.IsOvernight}.span()
+			if err != nil {
+				return err
+			}
+			spans = append(spans, span)
+		}
+		s.special[sh.Date] = spans
+	}
+	return nil
+}
+
+// Schedule returns the regular weekly Schedule of the business, overridden by
+// its SpecialHours.
+func (b *Business) Schedule(loc *time.Location) (*Schedule, error) {
+	s, err := NewSchedule(b.Hours, HoursTypeRegular, loc)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.AddSpecialHours(b.SpecialHours); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// IsOpenAt returns whether the business is open at t.
+func (s *Schedule) IsOpenAt(t time.Time) bool {
+	for _, iv := range s.intervals(t) {
+		if !t.Before(iv.start) && t.Before(iv.end) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOpen returns the first time after t at which the business opens. False is
+// returned if the business does not open within the following two weeks.
+func (s *Schedule) NextOpen(t time.Time) (time.Time, bool) {
+	for _, iv := range s.intervals(t) {
+		if iv.start.After(t) {
+			return iv.start, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// NextClose returns the first time after t at which the business closes. False
+// is returned if the business does not close within the following two weeks.
+func (s *Schedule) NextClose(t time.Time) (time.Time, bool) {
+	ivs := s.intervals(t)
+	for i, iv := range ivs {
+		if iv.end.After(t) {
+			// an interval that runs to the end of the window may not actually close
+			if i == len(ivs)-1 && !iv.end.Before(s.midnight(t, scheduleWindow)) {
+				return time.Time{}, false
+			}
+			return iv.end, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// intervals returns the merged opening intervals from the day before t until
+// the end of the schedule window, sorted by start time.
+func (s *Schedule) intervals(t time.Time) []interval {
+	var ivs []interval
+	for d := -1; d < scheduleWindow; d++ {
+		midnight := s.midnight(t, d)
+		for _, span := range s.spansOn(midnight) {
+			ivs = append(ivs, interval{
+				start: addWallClock(midnight, span.start),
+				end:   addWallClock(midnight, span.end),
+			})
+		}
+	}
+	return mergeIntervals(ivs)
+}
+
+// spansOn returns the opening spans that start on the day of midnight. Special
+// hours are used instead of the weekly spans when they exist for that date.
+func (s *Schedule) spansOn(midnight time.Time) []daySpan {
+	if spans, ok := s.special[midnight.Format(dateLayout)]; ok {
+		return spans
+	}
+	return s.days[yelpDay(midnight.Weekday())]
+}
+
+// midnight returns the start of the day that is days after the day of t.
+func (s *Schedule) midnight(t time.Time, days int) time.Time {
+	if s.loc != nil {
+		t = t.In(s.loc)
+	}
+	year, month, day := t.Date()
+	return time.Date(year, month, day+days, 0, 0, 0, 0, t.Location())
+}
+
+// addWallClock adds d to t as a change in wall clock time, so that opening
+// hours stay correct across daylight saving time transitions.
+func addWallClock(t time.Time, d time.Duration) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	return time.Date(year, month, day, hour, min, sec, t.Nanosecond()+int(d), t.Location())
+}
+
+// mergeIntervals sorts ivs and joins the intervals that overlap or touch.
+func mergeIntervals(ivs []interval) []interval {
+	sort.Slice(ivs, func(i, j int) bool {
+		return ivs[i].start.Before(ivs[j].start)
+	})
+
+	var merged []interval
+	for _, iv := range ivs {
+		if n := len(merged); n > 0 && !iv.start.After(merged[n-1].end) {
+			if iv.end.After(merged[n-1].end) {
+				merged[n-1].end = iv.end
+			}
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return merged
+}
+
+// yelpDay converts a time.Weekday to Yelp's day numbering, where 0 is Monday.
+func yelpDay(wd time.Weekday) int {
+	return (int(wd) + 6) % 7
+}
+
+// span parses the opening span of o.
+func (o Open) span() (daySpan, error) {
+	if o.Day < 0 || o.Day > 6 {
+		return daySpan{}, fmt.Errorf("Invalid day provided: %d", o.Day)
+	}
+	start, err := parseHHMM(o.Start)
+	if err != nil {
+		return daySpan{}, err
+	}
+	end, err := parseHHMM(o.End)
+	if err != nil {
+		return daySpan{}, err
+	}
+	// overnight spans, which Yelp flags with IsOvernight, end the following day
+	if end <= start {
+		end += 24 * time.Hour
+	}
+	return daySpan{start: start, end: end}, nil
+}
+
+// parseHHMM parses a time of day in Yelp's "HHMM" format, ie. "0930".
+func parseHHMM(hhmm string) (time.Duration, error) {
+	if len(hhmm) != 4 {
+		return 0, fmt.Errorf("Invalid time provided: %q", hhmm)
+	}
+	hour, hourErr := strconv.ParseUint(hhmm[:2], 10, 8)
+	min, minErr := strconv.ParseUint(hhmm[2:], 10, 8)
+	if hourErr != nil || minErr != nil || hour > 24 || min > 59 {
+		return 0, fmt.Errorf("Invalid time provided: %q", hhmm)
+	}
+	return time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute, nil
+}
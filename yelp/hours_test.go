@@ -0,0 +1,139 @@
+package yelp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSchedule(t *testing.T) {
+	loc := time.FixedZone("PST", -8*60*60)
+	hours := []Hours{
+		{
+			HoursType: HoursTypeRegular,
+			Open: []Open{
+				{Day: 0, Start: "0900", End: "1700"},
+				{Day: 4, Start: "1800", End: "0200", IsOvernight: true},
+				{Day: 6, Start: "2200", End: "0000", IsOvernight: true},
+			},
+		},
+		{
+			HoursType: HoursTypeRegular,
+			Open: []Open{
+				{Day: 0, Start: "1700", End: "2000"},
+			},
+		},
+		{
+			HoursType: "HAPPY_HOUR",
+			Open: []Open{
+				{Day: 0, Start: "1600", End: "1800"},
+			},
+		},
+	}
+	schedule, err := NewSchedule(hours, HoursTypeRegular, loc)
+	assert(t, err == nil, "Expected no error (%v) for valid hours", err)
+
+	// 2019-11-25 is a Monday
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2019, time.November, 25+day, hour, min, 0, 0, loc)
+	}
+
+	t.Run("IsOpenAt", func(t *testing.T) {
+		assert(t, !schedule.IsOpenAt(at(0, 8, 59)), "Should be closed before opening")
+		assert(t, schedule.IsOpenAt(at(0, 9, 0)), "Should be open at opening")
+		assert(t, schedule.IsOpenAt(at(0, 19, 0)), "Should be open during merged hours")
+		assert(t, !schedule.IsOpenAt(at(0, 20, 0)), "Should be closed at closing")
+		assert(t, schedule.IsOpenAt(at(5, 1, 30)), "Should be open overnight from Friday")
+		assert(t, !schedule.IsOpenAt(at(5, 2, 0)), "Should be closed after overnight hours")
+		assert(t, schedule.IsOpenAt(at(7, 0, 0).Add(-time.Minute)), "Should be open late on Sunday")
+		assert(t, schedule.IsOpenAt(at(0, 10, 0).UTC()), "Should evaluate times in the schedule's location")
+	})
+
+	t.Run("NextOpen", func(t *testing.T) {
+		next, ok := schedule.NextOpen(at(0, 12, 0))
+		assert(t, ok && next.Equal(at(4, 18, 0)), "Expected next open (%v) to be Friday 18:00", next)
+
+		next, ok = schedule.NextOpen(at(6, 23, 0))
+		assert(t, ok && next.Equal(at(7, 9, 0)), "Expected next open (%v) to be Monday 09:00", next)
+	})
+
+	t.Run("NextClose", func(t *testing.T) {
+		next, ok := schedule.NextClose(at(0, 10, 0))
+		assert(t, ok && next.Equal(at(0, 20, 0)), "Expected next close (%v) to be Monday 20:00", next)
+
+		next, ok = schedule.NextClose(at(6, 23, 0))
+		assert(t, ok && next.Equal(at(7, 0, 0)), "Expected next close (%v) to be Monday 00:00", next)
+	})
+
+	t.Run("Always open", func(t *testing.T) {
+		var open []Open
+		for day := 0; day < 7; day++ {
+			open = append(open, Open{Day: day, Start: "0000", End: "0000", IsOvernight: true})
+		}
+		always, err := NewSchedule([]Hours{{HoursType: HoursTypeRegular, Open: open}}, "", loc)
+		assert(t, err == nil, "Expected no error (%v) for valid hours", err)
+		assert(t, always.IsOpenAt(at(3, 3, 0)), "Should always be open")
+
+		_, ok := always.NextClose(at(3, 3, 0))
+		assert(t, !ok, "Should never close")
+	})
+
+	t.Run("SchedulesByType", func(t *testing.T) {
+		schedules, err := SchedulesByType(hours, loc)
+		assert(t, err == nil, "Expected no error (%v) for valid hours", err)
+		assert(t, len(schedules) == 2, "Expected 2 schedules, got %d", len(schedules))
+		assert(t, schedules["HAPPY_HOUR"].IsOpenAt(at(0, 16, 30)), "Should be happy hour")
+	})
+
+	t.Run("Invalid hours", func(t *testing.T) {
+		_, err := NewSchedule([]Hours{{HoursType: HoursTypeRegular, Open: []Open{{Day: 7, Start: "0900", End: "1700"}}}}, "", loc)
+		assert(t, err != nil, "Invalid day should error")
+
+		_, err = NewSchedule([]Hours{{HoursType: HoursTypeRegular, Open: []Open{{Day: 1, Start: "9am", End: "1700"}}}}, "", loc)
+		assert(t, err != nil, "Invalid time should error")
+	})
+}
+
+func TestScheduleDaylightSaving(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	// Sunday 2019-11-03 ends daylight saving time
+	schedule, err := NewSchedule([]Hours{{
+		HoursType: HoursTypeRegular,
+		Open:      []Open{{Day: 6, Start: "0800", End: "1200"}},
+	}}, "", loc)
+	assert(t, err == nil, "Expected no error (%v) for valid hours", err)
+
+	next, ok := schedule.NextOpen(time.Date(2019, time.November, 2, 12, 0, 0, 0, loc))
+	assert(t, ok && next.Equal(time.Date(2019, time.November, 3, 8, 0, 0, 0, loc)), "Expected next open (%v) to be 08:00 local time", next)
+}
+
+func TestBusinessScheduleSpecialHours(t *testing.T) {
+	loc := time.FixedZone("EST", -5*60*60)
+	var open []Open
+	for day := 0; day < 7; day++ {
+		open = append(open, Open{Day: day, Start: "1100", End: "2200"})
+	}
+	business := &Business{
+		Hours: []Hours{{HoursType: HoursTypeRegular, Open: open}},
+		SpecialHours: []SpecialHours{
+			{Date: "2019-11-28", IsClosed: true},
+			{Date: "2019-11-29", Start: "1400", End: "0100", IsOvernight: true},
+		},
+	}
+	schedule, err := business.Schedule(loc)
+	assert(t, err == nil, "Expected no error (%v) for valid hours", err)
+
+	assert(t, schedule.IsOpenAt(time.Date(2019, time.November, 27, 12, 0, 0, 0, loc)), "Should be open on a regular day")
+	assert(t, !schedule.IsOpenAt(time.Date(2019, time.November, 28, 12, 0, 0, 0, loc)), "Should be closed on Thanksgiving")
+	assert(t, !schedule.IsOpenAt(time.Date(2019, time.November, 29, 12, 0, 0, 0, loc)), "Should open late the day after Thanksgiving")
+	assert(t, schedule.IsOpenAt(time.Date(2019, time.November, 30, 0, 30, 0, 0, loc)), "Should be open overnight the day after Thanksgiving")
+
+	next, ok := schedule.NextOpen(time.Date(2019, time.November, 27, 23, 0, 0, 0, loc))
+	assert(t, ok && next.Equal(time.Date(2019, time.November, 29, 14, 0, 0, 0, loc)), "Expected next open (%v) to skip Thanksgiving", next)
+
+	business.SpecialHours = []SpecialHours{{Date: "Thanksgiving", IsClosed: true}}
+	_, err = business.Schedule(loc)
+	assert(t, err != nil, "Invalid special hours date should error")
+}
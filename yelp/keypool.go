@@ -0,0 +1,204 @@
+package yelp
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// defaultBenchDuration is how long a KeyPool stops using a key that was
+// rejected, when no duration is set.
+const defaultBenchDuration = 5 * time.Minute
+
+// ErrNoAPIKeys is returned when every key of a KeyPool is benched.
+var ErrNoAPIKeys = errors.New("no API keys are available")
+
+// Rate limit headers returned by the Yelp API.
+const (
+	headerDailyLimit = "RateLimit-DailyLimit"
+	headerRemaining  = "RateLimit-Remaining"
+	headerResetTime  = "RateLimit-ResetTime"
+)
+
+// KeyPool spreads requests across several API keys. Requests use the key with
+// the most remaining daily quota, as reported by the rate limit headers of
+// previous responses. Keys that are rejected with 401 Unauthorized or 429 Too
+// Many Requests are benched for BenchDuration, or until their quota resets. It
+// is safe for concurrent use.
+type KeyPool struct {
+	// BenchDuration is how long a rejected key is not used. Defaults to five
+	// minutes.
+	BenchDuration time.Duration
+
+	mu   sync.Mutex
+	keys []*poolKey
+
+	// now returns the current time.
+	now func() time.Time
+}
+
+// poolKey is an API key of a KeyPool and its usage.
+type poolKey struct {
+	key          string
+	dailyLimit   int64
+	remaining    int64
+	resetAt      time.Time
+	benchedUntil time.Time
+	requests     int64
+	rejections   int64
+}
+
+// KeyUsage is the usage of an API key of a KeyPool. Remaining and DailyLimit
+// are -1 until they are reported by the Yelp API.
+type KeyUsage struct {
+	// Key is the API key with all but its last four characters masked.
+	Key          string
+	Requests     int64
+	Rejections   int64
+	DailyLimit   int64
+	Remaining    int64
+	ResetAt      time.Time
+	BenchedUntil time.Time
+}
+
+// NewKeyPool returns a KeyPool of the given API keys.
+func NewKeyPool(apiKeys ...string) *KeyPool {
+	p := &KeyPool{now: time.Now}
+	for _, key := range apiKeys {
+		p.keys = append(p.keys, &poolKey{key: key, dailyLimit: -1, remaining: -1})
+	}
+	return p
+}
+
+// WithKeyPool makes the client use the API keys of p instead of its apiKey.
+// Requests without a body that are rejected by a key are retried with the next
+// available key.
+func WithKeyPool(p *KeyPool) Option {
+	return func(c *client) {
+		c.keys = p
+	}
+}
+
+// Len returns the number of keys in the pool.
+func (p *KeyPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.keys)
+}
+
+// Usage returns the usage of each key, in the order they were given.
+func (p *KeyPool) Usage() []KeyUsage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	usage := make([]KeyUsage, len(p.keys))
+	for i, k := range p.keys {
+		usage[i] = KeyUsage{
+			Key:          maskKey(k.key),
+			Requests:     k.requests,
+			Rejections:   k.rejections,
+			DailyLimit:   k.dailyLimit,
+			Remaining:    k.remaining,
+			ResetAt:      k.resetAt,
+			BenchedUntil: k.benchedUntil,
+		}
+	}
+	return usage
+}
+
+// next returns the unbenched key with the most remaining quota. Keys whose
+// quota is unknown are preferred, then keys with fewer requests.
+func (p *KeyPool) next() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	var best *poolKey
+	for _, k := range p.keys {
+		if now.Before(k.benchedUntil) {
+			continue
+		}
+		if !k.resetAt.IsZero() && !now.Before(k.resetAt) {
+			// the daily quota has reset since it was last reported
+			k.remaining, k.resetAt = -1, time.Time{}
+		}
+		if best == nil || preferKey(k, best) {
+			best = k
+		}
+	}
+	if best == nil {
+		return "", ErrNoAPIKeys
+	}
+	best.requests++
+	return best.key, nil
+}
+
+// preferKey returns whether a should be used before b.
+func preferKey(a, b *poolKey) bool {
+	switch {
+	case a.remaining == b.remaining:
+		return a.requests < b.requests
+	case a.remaining < 0:
+		return true
+	case b.remaining < 0:
+		return false
+	default:
+		return a.remaining > b.remaining
+	}
+}
+
+// record updates the usage of key from resp, and returns whether key was
+// rejected.
+func (p *KeyPool) record(key string, resp *http.Response) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var k *poolKey
+	for _, pk := range p.keys {
+		if pk.key == key {
+			k = pk
+			break
+		}
+	}
+	if k == nil {
+		return false
+	}
+
+	if limit, err := strconv.ParseInt(resp.Header.Get(headerDailyLimit), 10, 64); err == nil {
+		k.dailyLimit = limit
+	}
+	if remaining, err := strconv.ParseInt(resp.Header.Get(headerRemaining), 10, 64); err == nil {
+		k.remaining = remaining
+	}
+	if reset, err := time.Parse(time.RFC3339Nano, resp.Header.Get(headerResetTime)); err == nil {
+		k.resetAt = reset
+	}
+
+	if resp.StatusCode != http.StatusUnauthorized && resp.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+	k.rejections++
+	bench := p.BenchDuration
+	if bench <= 0 {
+		bench = defaultBenchDuration
+	}
+	k.benchedUntil = p.now().Add(bench)
+	if resp.StatusCode == http.StatusTooManyRequests && k.remaining == 0 && k.resetAt.After(k.benchedUntil) {
+		k.benchedUntil = k.resetAt
+	}
+	return true
+}
+
+// maskKey masks all but the last four characters of key.
+func maskKey(key string) string {
+	if len(key) <= 4 {
+		return key
+	}
+	masked := make([]byte, len(key))
+	for i := range masked {
+		masked[i] = '*'
+	}
+	copy(masked[len(key)-4:], key[len(key)-4:])
+	return string(masked)
+}
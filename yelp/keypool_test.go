@@ -0,0 +1,75 @@
+package yelp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestKeyPool(t *testing.T) {
+	ctx := context.Background()
+	reset := time.Now().Add(12 * time.Hour).UTC().Truncate(time.Second)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "Bearer revoked-key":
+			w.WriteHeader(http.StatusUnauthorized)
+		case "Bearer exhausted-key":
+			w.Header().Set(headerRemaining, "0")
+			w.Header().Set(headerResetTime, reset.Format(time.RFC3339))
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.Header().Set(headerDailyLimit, "5000")
+			w.Header().Set(headerRemaining, "4321")
+			w.Write([]byte(`{"id":"ditto"}`))
+		}
+	}))
+	defer server.Close()
+
+	pool := NewKeyPool("revoked-key", "exhausted-key", "working-key")
+	now := time.Now()
+	pool.now = func() time.Time { return now }
+	c := &client{Client: server.Client(), host: server.URL, keys: pool}
+
+	t.Run("Rejected keys fail over", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			b, err := c.GetBusiness(ctx, &GetBusinessOptions{ID: "ditto"})
+			assert(t, err == nil && b.ID == "ditto", "Expected the request to succeed with the working key (%v)", err)
+		}
+
+		usage := pool.Usage()
+		assert(t, usage[0].Key == "*******-key" && usage[0].Rejections == 1 && usage[0].Requests == 1, "Unexpected revoked key usage: %+v", usage[0])
+		assert(t, usage[0].BenchedUntil.Equal(now.Add(defaultBenchDuration)), "Expected the revoked key to be benched: %+v", usage[0])
+		assert(t, usage[1].Rejections == 1 && usage[1].Remaining == 0 && usage[1].BenchedUntil.Equal(reset), "Expected the exhausted key to be benched until reset: %+v", usage[1])
+		assert(t, usage[2].Requests == 3 && usage[2].Remaining == 4321 && usage[2].DailyLimit == 5000, "Unexpected working key usage: %+v", usage[2])
+	})
+
+	t.Run("Benched keys are used again", func(t *testing.T) {
+		now = now.Add(defaultBenchDuration)
+		_, err := c.GetBusiness(ctx, &GetBusinessOptions{ID: "ditto"})
+		assert(t, err == nil, "Expected the request to succeed (%v)", err)
+		assert(t, pool.Usage()[0].Rejections == 2, "Expected the revoked key to be retried after its bench")
+		assert(t, pool.Usage()[1].Rejections == 1, "Expected the exhausted key to stay benched until reset")
+	})
+
+	t.Run("Keys with the most quota are preferred", func(t *testing.T) {
+		p := NewKeyPool("a", "b", "c")
+		p.keys[0].remaining, p.keys[1].remaining = 10, 20
+		key, err := p.next()
+		assert(t, err == nil && key == "c", "Expected the key with unknown quota first, got %s (%v)", key, err)
+		p.keys[2].remaining = 5
+		key, _ = p.next()
+		assert(t, key == "b", "Expected the key with the most quota, got %s", key)
+	})
+
+	t.Run("Every key is benched", func(t *testing.T) {
+		p := NewKeyPool("revoked-key")
+		c := &client{Client: server.Client(), host: server.URL, keys: p}
+		_, err := c.GetBusiness(ctx, &GetBusinessOptions{ID: "ditto"})
+		assert(t, err != nil && err != ErrNoAPIKeys, "Expected the rejection to be returned (%v)", err)
+
+		_, err = c.GetBusiness(ctx, &GetBusinessOptions{ID: "ditto"})
+		assert(t, err == ErrNoAPIKeys, "Expected ErrNoAPIKeys when every key is benched, got %v", err)
+	})
+}
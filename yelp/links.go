@@ -0,0 +1,188 @@
+package yelp
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Hosts and schemes of the links to Yelp.
+const (
+	webHost       = "www.yelp.com"
+	mobileWebHost = "m.yelp.com"
+	appScheme     = "yelp"
+)
+
+// defaultWebRadius is the radius in meters of the map area of a search URL for
+// options with Coordinates but no Radius.
+const defaultWebRadius = 10000
+
+// Tracking contains the UTM parameters added to links to Yelp. Empty fields are
+// left out.
+type Tracking struct {
+	Source   string
+	Medium   string
+	Campaign string
+	Content  string
+	Term     string
+}
+
+// URLValues returns Tracking as url.Values.
+func (t *Tracking) URLValues() url.Values {
+	vals := url.Values{}
+	if t == nil {
+		return vals
+	}
+	for name, value := range map[string]string{
+		"utm_source":   t.Source,
+		"utm_medium":   t.Medium,
+		"utm_campaign": t.Campaign,
+		"utm_content":  t.Content,
+		"utm_term":     t.Term,
+	} {
+		if value != "" {
+			vals.Set(name, value)
+		}
+	}
+	return vals
+}
+
+// BusinessLinks are links to a business on Yelp.
+type BusinessLinks struct {
+	// Web is the canonical yelp.com page, ie. "https://www.yelp.com/biz/alias".
+	Web string
+	// MobileWeb is the m.yelp.com page.
+	MobileWeb string
+	// App opens the business in the Yelp app, ie. "yelp:///biz/alias".
+	App string
+}
+
+// Links returns links to the business with the tracking parameters. The alias
+// of the business is taken from `Alias`, then from `URL`, and the ID is used if
+// neither is set.
+func (b *Business) Links(t *Tracking) (*BusinessLinks, error) {
+	alias := StringValue(b.Alias)
+	if alias == "" && b.URL != "" {
+		if gbo, _, err := ParseBusinessURL(b.URL); err == nil {
+			alias = gbo.ID
+		}
+	}
+	if alias == "" {
+		alias = b.ID
+	}
+	if alias == "" {
+		return nil, errors.New("Business has no alias or ID")
+	}
+
+	path := "/biz/" + url.PathEscape(alias) + queryString(t.URLValues().Encode())
+	return &BusinessLinks{
+		Web:       "https://" + webHost + path,
+		MobileWeb: "https://" + mobileWebHost + path,
+		App:       appScheme + "://" + path,
+	}, nil
+}
+
+// SearchURL returns the yelp.com search URL equivalent to the options, with the
+// tracking parameters. Coordinates are shown as the map area of their Radius.
+// The names of the options which yelp.com search URLs cannot express, ie.
+// "Locale", are returned as unsupported.
+func SearchURL(bso *BusinessSearchOptions, t *Tracking) (string, []string, error) {
+	if bso == nil {
+		return "", nil, errors.New("BusinessSearchOptions are unset")
+	}
+
+	vals := t.URLValues()
+	var unsupported []string
+	if bso.Term != nil {
+		vals.Set("find_desc", *bso.Term)
+	}
+	if bso.Coordinates != nil {
+		radius := float64(defaultWebRadius)
+		if bso.Radius != nil {
+			radius = float64(*bso.Radius)
+		}
+		box := NewBoundingBox(*bso.Coordinates, radius)
+		vals.Set("l", fmt.Sprintf("g:%s,%s,%s,%s",
+			FloatString(box.SouthWest.Longitude), FloatString(box.SouthWest.Latitude),
+			FloatString(box.NorthEast.Longitude), FloatString(box.NorthEast.Latitude)))
+	} else {
+		if bso.Location != nil {
+			vals.Set("find_loc", *bso.Location)
+		}
+		if bso.Radius != nil {
+			unsupported = append(unsupported, "Radius")
+		}
+	}
+	if len(bso.Categories) > 0 {
+		vals.Set("cflt", strings.Join(bso.Categories, ","))
+	}
+
+	var attrs []string
+	for _, p := range bso.Price {
+		attrs = append(attrs, webPricePrefix+IntString(int64(p)))
+	}
+	for _, a := range bso.Attributes {
+		attr, ok := webAttributeNames()[a]
+		if !ok {
+			return "", nil, fmt.Errorf("Invalid attribute provided: %s", a)
+		}
+		attrs = append(attrs, attr)
+	}
+	if len(attrs) > 0 {
+		vals.Set("attrs", strings.Join(attrs, ","))
+	}
+
+	if bso.SortBy != nil {
+		sortBy, ok := webSortNames()[*bso.SortBy]
+		if !ok {
+			return "", nil, fmt.Errorf("Invalid sort by provided: %s", *bso.SortBy)
+		}
+		vals.Set("sortby", sortBy)
+	}
+	if bso.Offset != nil {
+		vals.Set("start", IntString(*bso.Offset))
+	}
+
+	for name, set := range map[string]bool{
+		"Locale":  bso.Locale != nil,
+		"Limit":   bso.Limit != nil,
+		"OpenNow": bso.OpenNow != nil,
+		"OpenAt":  bso.OpenAt != nil,
+	} {
+		if set {
+			unsupported = append(unsupported, name)
+		}
+	}
+	sort.Strings(unsupported)
+
+	u := url.URL{Scheme: "https", Host: webHost, Path: "/search", RawQuery: vals.Encode()}
+	return u.String(), unsupported, nil
+}
+
+// webAttributeNames returns the "attrs" value of each Attribute.
+func webAttributeNames() map[Attribute]string {
+	names := map[Attribute]string{}
+	for name, a := range webAttributes {
+		names[a] = name
+	}
+	return names
+}
+
+// webSortNames returns the "sortby" value of each SortBy.
+func webSortNames() map[SortBy]string {
+	names := map[SortBy]string{}
+	for name, s := range webSorts {
+		names[s] = name
+	}
+	return names
+}
+
+// queryString prefixes a non-empty encoded query with "?".
+func queryString(query string) string {
+	if query == "" {
+		return ""
+	}
+	return "?" + query
+}
@@ -0,0 +1,73 @@
+package yelp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBusinessLinks(t *testing.T) {
+	tracking := &Tracking{Source: "pokedex", Campaign: "gym-guide"}
+
+	t.Run("Alias from the API URL", func(t *testing.T) {
+		b := &Business{ID: "abc123", URL: "https://www.yelp.com/biz/saffron-caf%C3%A9-viridian-city?adjust_creative=xyz&utm_source=xyz"}
+		links, err := b.Links(tracking)
+		assert(t, err == nil, "Expected links (%v)", err)
+		assert(t, links.Web == "https://www.yelp.com/biz/saffron-caf%C3%A9-viridian-city?utm_campaign=gym-guide&utm_source=pokedex", "Unexpected web link %s", links.Web)
+		assert(t, links.MobileWeb == "https://m.yelp.com/biz/saffron-caf%C3%A9-viridian-city?utm_campaign=gym-guide&utm_source=pokedex", "Unexpected mobile link %s", links.MobileWeb)
+		assert(t, links.App == "yelp:///biz/saffron-caf%C3%A9-viridian-city?utm_campaign=gym-guide&utm_source=pokedex", "Unexpected app link %s", links.App)
+	})
+
+	t.Run("Alias and ID", func(t *testing.T) {
+		links, _ := (&Business{ID: "abc123", Alias: StringPointer("viridian-gym")}).Links(nil)
+		assert(t, links.Web == "https://www.yelp.com/biz/viridian-gym" && links.App == "yelp:///biz/viridian-gym", "Unexpected links %+v", links)
+
+		links, _ = (&Business{ID: "abc123"}).Links(nil)
+		assert(t, links.Web == "https://www.yelp.com/biz/abc123", "Expected the ID to be used, got %s", links.Web)
+
+		_, err := (&Business{}).Links(nil)
+		assert(t, err != nil, "Expected a business without an alias or ID to error")
+	})
+}
+
+func TestSearchURL(t *testing.T) {
+	t.Run("Round trip", func(t *testing.T) {
+		bso := &BusinessSearchOptions{
+			Term:       StringPointer("ramen"),
+			Location:   StringPointer("Saffron City"),
+			Categories: []string{"ramen", "japanese"},
+			Price:      PriceLevels{PriceInexpensive, PriceModerate},
+			Attributes: Attributes{AttributeHotAndNew},
+			SortBy:     SortByPointer(SortByBestMatch),
+			Offset:     Int64Pointer(20),
+			Locale:     StringPointer("ja_JP"),
+			Limit:      Int64Pointer(10),
+		}
+		searchURL, unsupported, err := SearchURL(bso, &Tracking{Source: "pokedex"})
+		assert(t, err == nil, "Expected a search URL (%v)", err)
+		assert(t, searchURL == "https://www.yelp.com/search?attrs=RestaurantsPriceRange2.1%2CRestaurantsPriceRange2.2%2CHotAndNew"+
+			"&cflt=ramen%2Cjapanese&find_desc=ramen&find_loc=Saffron+City&sortby=recommended&start=20&utm_source=pokedex", "Unexpected search URL %s", searchURL)
+		assert(t, reflect.DeepEqual(unsupported, []string{"Limit", "Locale"}), "Unexpected unsupported options %v", unsupported)
+
+		parsed, unsupported, err := ParseSearchURL(searchURL)
+		assert(t, err == nil && reflect.DeepEqual(unsupported, []string{"utm_source"}), "Expected the URL to parse (%v, %v)", unsupported, err)
+		bso.Locale, bso.Limit = nil, nil
+		assert(t, reflect.DeepEqual(parsed, bso), "Expected %+v, got %+v", bso, parsed)
+	})
+
+	t.Run("Coordinates", func(t *testing.T) {
+		center := Coordinates{Latitude: 35.68, Longitude: 139.76}
+		searchURL, _, err := SearchURL(&BusinessSearchOptions{Coordinates: &center, Radius: Int64Pointer(1000)}, nil)
+		assert(t, err == nil, "Expected a search URL (%v)", err)
+
+		parsed, _, err := ParseSearchURL(searchURL)
+		assert(t, err == nil && parsed.Coordinates.DistanceTo(center) < 1, "Expected the map area around %+v, got %+v (%v)", center, parsed.Coordinates, err)
+		assert(t, Int64Value(parsed.Radius) >= 1000 && Int64Value(parsed.Radius) < 1500, "Expected a radius covering the map area, got %d", Int64Value(parsed.Radius))
+	})
+
+	t.Run("Invalid options", func(t *testing.T) {
+		_, _, err := SearchURL(&BusinessSearchOptions{SortBy: SortByPointer("alphabetical")}, nil)
+		assert(t, err != nil, "Expected an invalid sort to error")
+		_, _, err = SearchURL(nil, nil)
+		assert(t, err != nil, "Expected nil options to error")
+	})
+}
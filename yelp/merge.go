@@ -0,0 +1,117 @@
+package yelp
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Source is the API a business was fetched from.
+type Source string
+
+// The sources of the fields of a MergedBusiness.
+const (
+	SourceSearch  Source = "search"
+	SourceDetails Source = "details"
+)
+
+// SourcedBusiness is a business as returned by a source at a point in time.
+type SourcedBusiness struct {
+	Business  Business
+	Source    Source
+	FetchedAt time.Time
+}
+
+// FieldSource is where a field of a MergedBusiness came from.
+type FieldSource struct {
+	Source    Source    `json:"source"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// MergedBusiness is a business combined from a Business Search result and its
+// Get Business details. Sources is keyed by the JSON name of each field, ie.
+// "distance".
+type MergedBusiness struct {
+	Business
+	Sources map[string]FieldSource `json:"sources"`
+}
+
+// mergeRule chooses which of two sources a field is taken from.
+type mergeRule int
+
+const (
+	// preferDetails takes the field from the details, which have the complete
+	// and canonical values of static fields. This is the default.
+	preferDetails mergeRule = iota
+	// preferSearch takes the field from the search result, ie. `Distance`, which
+	// only makes sense relative to the search.
+	preferSearch
+	// preferNewer takes the field from whichever source was fetched last, for
+	// fields that change often.
+	preferNewer
+)
+
+// mergeRules are the rules of the fields which do not prefer the details, keyed
+// by JSON name.
+var mergeRules = map[string]mergeRule{
+	"distance":     preferSearch,
+	"is_closed":    preferNewer,
+	"rating":       preferNewer,
+	"review_count": preferNewer,
+}
+
+// Merge combines a business's search result with its details. Details win for
+// static fields, the search result wins for `Distance`, and whichever was
+// fetched last wins for `IsClosed`, `Rating` and `ReviewCount`. Fields left
+// empty by the winning source are taken from the other one. Either business
+// may be empty, in which case every field comes from the other.
+func Merge(search, details SourcedBusiness) (*MergedBusiness, error) {
+	if search.Source == "" {
+		search.Source = SourceSearch
+	}
+	if details.Source == "" {
+		details.Source = SourceDetails
+	}
+	if search.Business.ID != "" && details.Business.ID != "" && search.Business.ID != details.Business.ID {
+		return nil, fmt.Errorf("Cannot merge different businesses: %s and %s", search.Business.ID, details.Business.ID)
+	}
+
+	merged := &MergedBusiness{Sources: map[string]FieldSource{}}
+	out := reflect.ValueOf(&merged.Business).Elem()
+	searchVal, detailsVal := reflect.ValueOf(search.Business), reflect.ValueOf(details.Business)
+	for i := 0; i < out.NumField(); i++ {
+		name := jsonName(out.Type().Field(i))
+
+		preferred, other := details, search
+		prefVal, otherVal := detailsVal.Field(i), searchVal.Field(i)
+		switch mergeRules[name] {
+		case preferSearch:
+			preferred, other, prefVal, otherVal = search, details, otherVal, prefVal
+		case preferNewer:
+			if search.FetchedAt.After(details.FetchedAt) {
+				preferred, other, prefVal, otherVal = search, details, otherVal, prefVal
+			}
+		}
+
+		// a missing business or a field it left empty falls back to the other
+		// source, though false is a meaningful value for bools
+		if preferred.Business.ID == "" && other.Business.ID != "" ||
+			prefVal.Kind() != reflect.Bool && prefVal.IsZero() && !otherVal.IsZero() {
+			preferred, prefVal = other, otherVal
+		}
+
+		out.Field(i).Set(prefVal)
+		merged.Sources[name] = FieldSource{Source: preferred.Source, FetchedAt: preferred.FetchedAt}
+	}
+	return merged, nil
+}
+
+// jsonName returns the JSON name of a struct field.
+func jsonName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
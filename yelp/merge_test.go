@@ -0,0 +1,64 @@
+package yelp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMerge(t *testing.T) {
+	searchedAt := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	fetchedAt := searchedAt.Add(-time.Hour)
+	search := SourcedBusiness{
+		Business: Business{
+			ID:          "snorlax-cafe",
+			Name:        "Snorlax Cafe",
+			Distance:    120.5,
+			Rating:      4.5,
+			ReviewCount: 143,
+			Price:       "$$",
+		},
+		FetchedAt: searchedAt,
+	}
+	details := SourcedBusiness{
+		Business: Business{
+			ID:          "snorlax-cafe",
+			Name:        "Snorlax Café",
+			Rating:      4,
+			ReviewCount: 140,
+			Alias:       StringPointer("snorlax-cafe-celadon-city"),
+			Photos:      []string{"https://example.com/snorlax.jpg"},
+			Hours:       []Hours{{HoursType: HoursTypeRegular, Open: []Open{{Day: 0, Start: "0900", End: "1700"}}}},
+		},
+		FetchedAt: fetchedAt,
+	}
+
+	t.Run("Precedence", func(t *testing.T) {
+		m, err := Merge(search, details)
+		assert(t, err == nil, "Expected the merge to succeed (%v)", err)
+		assert(t, m.Name == "Snorlax Café" && m.Sources["name"].Source == SourceDetails, "Expected the details name, got %s", m.Name)
+		assert(t, m.Distance == 120.5 && m.Sources["distance"] == FieldSource{SourceSearch, searchedAt}, "Expected the search distance, got %v", m.Distance)
+		assert(t, m.Rating == 4.5 && m.ReviewCount == 143 && m.Sources["rating"].Source == SourceSearch, "Expected the newer search rating, got %v", m.Rating)
+		assert(t, m.Price == "$$" && m.Sources["price"].Source == SourceSearch, "Expected the price to fall back to the search result")
+		assert(t, *m.Alias == "snorlax-cafe-celadon-city" && len(m.Photos) == 1 && len(m.Hours) == 1, "Expected the details only fields")
+		assert(t, m.Sources["hours"] == FieldSource{SourceDetails, fetchedAt}, "Unexpected hours source %+v", m.Sources["hours"])
+	})
+
+	t.Run("Newer details", func(t *testing.T) {
+		details := details
+		details.FetchedAt = searchedAt.Add(time.Hour)
+		m, _ := Merge(search, details)
+		assert(t, m.Rating == 4 && m.ReviewCount == 140 && m.Sources["review_count"].Source == SourceDetails, "Expected the newer details rating, got %v", m.Rating)
+	})
+
+	t.Run("Missing details", func(t *testing.T) {
+		m, err := Merge(search, SourcedBusiness{})
+		assert(t, err == nil && m.Name == "Snorlax Cafe" && m.Sources["is_closed"].Source == SourceSearch, "Expected every field from the search result (%v)", err)
+	})
+
+	t.Run("Different businesses", func(t *testing.T) {
+		details := details
+		details.Business.ID = "munchlax-cafe"
+		_, err := Merge(search, details)
+		assert(t, err != nil, "Expected different businesses to error")
+	})
+}
@@ -0,0 +1,208 @@
+package yelp
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultLocale is the locale Yelp uses when none is provided.
+const DefaultLocale = "en_US"
+
+// Confidence is how closely a negotiated locale matches the requested language.
+type Confidence int
+
+// The confidence levels of a negotiated locale, from worst to best.
+const (
+	// MatchNone means no supported locale matched and DefaultLocale was chosen.
+	MatchNone Confidence = iota
+	// MatchLow means the language matched, but not the requested region or script.
+	MatchLow
+	// MatchHigh means the language matched and no region was requested.
+	MatchHigh
+	// MatchExact means the requested language and region are supported.
+	MatchExact
+)
+
+// String returns the name of the confidence level.
+func (c Confidence) String() string {
+	switch c {
+	case MatchNone:
+		return "none"
+	case MatchLow:
+		return "low"
+	case MatchHigh:
+		return "high"
+	case MatchExact:
+		return "exact"
+	default:
+		return "Confidence(" + strconv.Itoa(int(c)) + ")"
+	}
+}
+
+// defaultRegions are the regions of the locale chosen for a language tag without
+// a supported region.
+var defaultRegions = map[string]string{
+	"cs":  "CZ",
+	"da":  "DK",
+	"de":  "DE",
+	"en":  "US",
+	"es":  "ES",
+	"fi":  "FI",
+	"fil": "PH",
+	"fr":  "FR",
+	"it":  "IT",
+	"ja":  "JP",
+	"ms":  "MY",
+	"nb":  "NO",
+	"nl":  "NL",
+	"pl":  "PL",
+	"pt":  "BR",
+	"sv":  "SE",
+	"tr":  "TR",
+	"zh":  "TW",
+}
+
+// languageAliases maps languages Yelp does not support to an equivalent one.
+var languageAliases = map[string]string{
+	"no": "nb",
+	"nn": "nb",
+	"tl": "fil",
+}
+
+// NegotiateLocale picks the supported Yelp locale that best matches an
+// Accept-Language header value, ie. "fr-CA,fr;q=0.9,en;q=0.8". The first
+// language in order of preference with any match wins, even if a less preferred
+// language matches with a higher confidence, so "en-IN,de;q=0.5" picks en_US.
+// DefaultLocale is returned with MatchNone if no language matches.
+func NegotiateLocale(acceptLanguage string) (string, Confidence) {
+	return MatchLocale(ParseAcceptLanguage(acceptLanguage)...)
+}
+
+// MatchLocale picks the supported Yelp locale that best matches the BCP 47
+// language tags, given in order of preference. See NegotiateLocale.
+func MatchLocale(tags ...string) (string, Confidence) {
+	for _, tag := range tags {
+		if locale, confidence := matchTag(tag); confidence > MatchNone {
+			return locale, confidence
+		}
+	}
+	return DefaultLocale, MatchNone
+}
+
+// ParseAcceptLanguage returns the language tags of an Accept-Language header
+// value sorted by quality, most preferred first. Tags with a quality of 0 and
+// the "*" wildcard are left out.
+func ParseAcceptLanguage(acceptLanguage string) []string {
+	type weighted struct {
+		tag     string
+		quality float64
+	}
+
+	var tags []weighted
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		params := strings.Split(part, ";")
+		tag := strings.TrimSpace(params[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+			q, err := strconv.ParseFloat(param[2:], 64)
+			if err != nil || q < 0 || q > 1 {
+				q = 0
+			}
+			quality = q
+		}
+		if quality > 0 {
+			tags = append(tags, weighted{tag: tag, quality: quality})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].quality > tags[j].quality
+	})
+
+	strs := make([]string, len(tags))
+	for i, t := range tags {
+		strs[i] = t.tag
+	}
+	return strs
+}
+
+// matchTag returns the supported locale that best matches a single BCP 47
+// language tag, ie. "en-IN" or "zh_Hant_HK".
+func matchTag(tag string) (string, Confidence) {
+	subtags := strings.Split(strings.ReplaceAll(tag, "-", "_"), "_")
+	language := strings.ToLower(subtags[0])
+	var script, region string
+	for _, subtag := range subtags[1:] {
+		switch {
+		case len(subtag) == 4 && script == "" && region == "":
+			script = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		case (len(subtag) == 2 || len(subtag) == 3 && isDigits(subtag)) && region == "":
+			region = strings.ToUpper(subtag)
+		}
+	}
+
+	confidence := MatchExact
+	if alias, ok := languageAliases[language]; ok {
+		language, confidence = alias, MatchHigh
+	}
+	if _, ok := defaultRegions[language]; !ok {
+		return DefaultLocale, MatchNone
+	}
+
+	// Yelp only supports traditional Chinese
+	if language == "zh" {
+		switch {
+		case region == "HK" || region == "MO":
+			return "zh_HK", minConfidence(confidence, matchScript(script, "Hant"))
+		case region == "TW":
+			return "zh_TW", minConfidence(confidence, matchScript(script, "Hant"))
+		case region == "" && script == "Hant":
+			return "zh_TW", MatchHigh
+		default:
+			return "zh_TW", MatchLow
+		}
+	}
+
+	if region != "" {
+		if _, ok := validLocales[language+"_"+region]; ok {
+			return language + "_" + region, confidence
+		}
+		return language + "_" + defaultRegions[language], MatchLow
+	}
+	return language + "_" + defaultRegions[language], MatchHigh
+}
+
+// matchScript returns MatchExact if the requested script is empty or want, and
+// MatchLow otherwise.
+func matchScript(script, want string) Confidence {
+	if script == "" || script == want {
+		return MatchExact
+	}
+	return MatchLow
+}
+
+// minConfidence returns the lower of a and b.
+func minConfidence(a, b Confidence) Confidence {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// isDigits returns whether s only contains ASCII digits.
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,40 @@
+package yelp
+
+import "testing"
+
+func TestNegotiateLocale(t *testing.T) {
+	for _, tc := range []struct {
+		acceptLanguage string
+		locale         string
+		confidence     Confidence
+	}{
+		{"fr-CA,fr;q=0.9,en;q=0.8", "fr_CA", MatchExact},
+		{"fr", "fr_FR", MatchHigh},
+		{"en-IN", "en_US", MatchLow},
+		{"en-IN,de;q=0.5", "en_US", MatchLow},
+		{"de;q=0.5,en-IN", "en_US", MatchLow},
+		{"xx,de-AT;q=0.5,fr", "fr_FR", MatchHigh},
+		{"de;q=0.5,en-gb", "en_GB", MatchExact},
+		{"pt_br", "pt_BR", MatchExact},
+		{"zh-Hant-HK", "zh_HK", MatchExact},
+		{"ZH-HANT-hk", "zh_HK", MatchExact},
+		{"zh-Hant", "zh_TW", MatchHigh},
+		{"zh-CN", "zh_TW", MatchLow},
+		{"no-NO", "nb_NO", MatchHigh},
+		{"es-419", "es_ES", MatchLow},
+		{"ko-KR,*;q=0.1", DefaultLocale, MatchNone},
+		{"fr;q=0,ja", "ja_JP", MatchHigh},
+		{"", DefaultLocale, MatchNone},
+	} {
+		locale, confidence := NegotiateLocale(tc.acceptLanguage)
+		assert(t, locale == tc.locale && confidence == tc.confidence,
+			"Expected %q to negotiate %s (%s), got %s (%s)", tc.acceptLanguage, tc.locale, tc.confidence, locale, confidence)
+		assert(t, ValidateLocale(locale) == nil, "Expected %s to be a valid locale", locale)
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tags := ParseAcceptLanguage("en;q=0.8, fr-CA , fr;q=0.9, de;q=0.8, *;q=0.5, it;q=0")
+	assert(t, len(tags) == 4, "Expected 4 tags, got %v", tags)
+	assert(t, tags[0] == "fr-CA" && tags[1] == "fr" && tags[2] == "en" && tags[3] == "de", "Unexpected tag order %v", tags)
+}
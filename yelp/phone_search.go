@@ -0,0 +1,65 @@
+package yelp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// PhoneSearchOptions contains the available parameters for the Phone Search
+// API.
+type PhoneSearchOptions struct {
+	// Phone is the phone number to search for, starting with "+" and the country
+	// code, ie. "+14159083801".
+	Phone  string
+	Locale *string
+}
+
+// PhoneSearch makes a request given the options provided. Only the `Total` and
+// `Businesses` of the results are set.
+func (c *client) PhoneSearch(ctx context.Context, pso *PhoneSearchOptions) (*BusinessSearchResults, error) {
+	if err := pso.Validate(); err != nil {
+		return nil, err
+	}
+	var respBody BusinessSearchResults
+	_, err := c.authedDo(ctx, http.MethodGet, phoneSearchPath(pso), nil, nil, &respBody)
+	return &respBody, err
+}
+
+// phoneSearchPath returns the phone search path with parameters.
+func phoneSearchPath(pso *PhoneSearchOptions) string {
+	return fmt.Sprintf("/v3/businesses/search/phone?%s", pso.URLValues().Encode())
+}
+
+// Validate returns an error with details when PhoneSearchOptions are not valid.
+func (pso *PhoneSearchOptions) Validate() error {
+	switch {
+	case pso == nil:
+		return errors.New("PhoneSearchOptions are unset")
+	case pso.Phone == "":
+		return errors.New("PhoneSearchOptions `Phone` is not set")
+	case !strings.HasPrefix(pso.Phone, "+"):
+		return fmt.Errorf("PhoneSearchOptions `Phone` must start with + and the country code: %s", pso.Phone)
+	case pso.Locale != nil && ValidateLocale(*pso.Locale) != nil:
+		return fmt.Errorf("PhoneSearchOptions `Locale` is invalid: %s", *pso.Locale)
+	default:
+		return nil
+	}
+}
+
+// URLValues returns PhoneSearchOptions as url.Values.
+func (pso *PhoneSearchOptions) URLValues() url.Values {
+	if pso == nil {
+		return nil
+	}
+
+	vals := url.Values{}
+	vals.Add("phone", pso.Phone)
+	if pso.Locale != nil {
+		vals.Add("locale", *pso.Locale)
+	}
+	return vals
+}
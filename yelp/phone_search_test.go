@@ -0,0 +1,41 @@
+package yelp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPhoneSearch(t *testing.T) {
+	ctx := context.Background()
+	mocks := &testMocks{}
+	options := &PhoneSearchOptions{Phone: "4155550100"}
+	t.Run("invalid options", func(t *testing.T) {
+		client := newTestClient(nil, "API_KEY", mocks)
+		_, err := client.PhoneSearch(ctx, options)
+		assert(t, err != nil, "Expected an error when the phone number has no country code")
+	})
+
+	t.Run("failed request", func(t *testing.T) {
+		options.Phone = "+14155550100"
+		mocks.mockRequest(http.MethodGet, phoneSearchPath(options), http.StatusInternalServerError, errors.New("Internal server error"))
+		client := newTestClient(mocks.server.Client(), "API_KEY", mocks)
+
+		_, err := client.PhoneSearch(ctx, options)
+		assert(t, err != nil, "Expected an error when request fails")
+	})
+
+	t.Run("successful request", func(t *testing.T) {
+		expected := BusinessSearchResults{Total: 1, Businesses: []Business{{ID: "pokemon-center", Phone: "+14155550101"}}}
+		options.Phone = "+14155550101"
+		options.Locale = StringPointer("en_US")
+		mocks.mockRequest(http.MethodGet, phoneSearchPath(options), http.StatusOK, expected)
+		client := newTestClient(mocks.server.Client(), "API_KEY", mocks)
+
+		results, err := client.PhoneSearch(ctx, options)
+		assert(t, err == nil, "Expected no error (%v) when request succeeds", err)
+		assert(t, reflect.DeepEqual(*results, expected), "Results (%v) did not match expected (%v)", results, expected)
+	})
+}
@@ -0,0 +1,77 @@
+package yelp
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the rate of requests made by a client. It is satisfied by
+// *rate.Limiter from golang.org/x/time/rate.
+type RateLimiter interface {
+	// Wait blocks until a request may be made or ctx is done.
+	Wait(ctx context.Context) error
+}
+
+// NewRateLimiter returns a token bucket RateLimiter which allows perSecond
+// requests on average, with bursts of up to burst requests. A perSecond of 0 or
+// less does not limit requests.
+func NewRateLimiter(perSecond float64, burst int) RateLimiter {
+	if perSecond <= 0 {
+		return unlimited{}
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		interval: time.Duration(float64(time.Second) / perSecond),
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+// tokenBucket implements the RateLimiter interface.
+type tokenBucket struct {
+	mu       sync.Mutex
+	interval time.Duration
+	burst    float64
+	tokens   float64
+	last     time.Time
+}
+
+// Wait implements the RateLimiter interface.
+func (tb *tokenBucket) Wait(ctx context.Context) error {
+	for {
+		tb.mu.Lock()
+		now := time.Now()
+		tb.tokens += float64(now.Sub(tb.last)) / float64(tb.interval)
+		if tb.tokens > tb.burst {
+			tb.tokens = tb.burst
+		}
+		tb.last = now
+		if tb.tokens >= 1 {
+			tb.tokens--
+			tb.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - tb.tokens) * float64(tb.interval))
+		tb.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// unlimited is a RateLimiter which allows every request.
+type unlimited struct{}
+
+// Wait implements the RateLimiter interface.
+func (unlimited) Wait(ctx context.Context) error {
+	return ctx.Err()
+}
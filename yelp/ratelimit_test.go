@@ -0,0 +1,56 @@
+package yelp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	limiter := NewRateLimiter(100, 2)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		assert(t, limiter.Wait(ctx) == nil, "Expected no error waiting")
+	}
+	elapsed := time.Since(start)
+	assert(t, elapsed >= 15*time.Millisecond, "Expected requests past the burst to wait, took %v", elapsed)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	for limiter.Wait(canceled) == nil {
+	}
+	assert(t, limiter.Wait(canceled) == context.Canceled, "Expected waiting to stop when ctx is done")
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	ctx := context.Background()
+	for _, perSecond := range []float64{0, -1} {
+		limiter := NewRateLimiter(perSecond, 1)
+		start := time.Now()
+		for i := 0; i < 100; i++ {
+			assert(t, limiter.Wait(ctx) == nil, "Expected no error waiting")
+		}
+		elapsed := time.Since(start)
+		assert(t, elapsed < 100*time.Millisecond, "Expected a rate of %v to not limit requests, took %v", perSecond, elapsed)
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		assert(t, limiter.Wait(canceled) == context.Canceled, "Expected waiting to stop when ctx is done")
+	}
+}
+
+// errLimiter is a RateLimiter which never allows requests.
+type errLimiter struct{}
+
+func (errLimiter) Wait(ctx context.Context) error {
+	return errors.New("rate limited")
+}
+
+func TestWithRateLimiter(t *testing.T) {
+	c := New(nil, "API_KEY", WithRateLimiter(errLimiter{}))
+	_, err := c.GetBusiness(context.Background(), &GetBusinessOptions{ID: "snorlax"})
+	assert(t, err != nil && err.Error() == "rate limited", "Expected the rate limiter to stop the request: %v", err)
+}
@@ -0,0 +1,102 @@
+package yelp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// maxReviewsLimit is the largest number of reviews returned per request.
+const maxReviewsLimit = 50
+
+// ReviewsOptions contains the available parameters for the Reviews API.
+type ReviewsOptions struct {
+	ID     string
+	Locale *string
+	Limit  *int64
+	Offset *int64
+}
+
+// ReviewsResults reflects the JSON returned by the Reviews API.
+type ReviewsResults struct {
+	Total             int64    `json:"total"`
+	Reviews           []Review `json:"reviews"`
+	PossibleLanguages []string `json:"possible_languages"`
+}
+
+// Review is an excerpt of a review of a business.
+type Review struct {
+	ID          string `json:"id"`
+	Rating      int64  `json:"rating"`
+	Text        string `json:"text"`
+	TimeCreated string `json:"time_created"`
+	URL         string `json:"url"`
+	User        User   `json:"user"`
+}
+
+// User is the author of a review.
+type User struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	ProfileURL string `json:"profile_url"`
+	ImageURL   string `json:"image_url"`
+}
+
+// Reviews makes a request given the options provided.
+func (c *client) Reviews(ctx context.Context, ro *ReviewsOptions) (*ReviewsResults, error) {
+	if err := ro.Validate(); err != nil {
+		return nil, err
+	}
+	var respBody ReviewsResults
+	_, err := c.authedDo(ctx, http.MethodGet, reviewsPath(ro), nil, nil, &respBody)
+	return &respBody, err
+}
+
+// reviewsPath returns the reviews path with parameters.
+func reviewsPath(ro *ReviewsOptions) string {
+	return fmt.Sprintf("/v3/businesses/%s/reviews?%s", url.PathEscape(ro.ID), ro.URLValues().Encode())
+}
+
+// Validate returns an error with details when ReviewsOptions are not valid.
+// Every invalid field is reported in the returned *ValidationError.
+func (ro *ReviewsOptions) Validate() error {
+	if ro == nil {
+		return errors.New("ReviewsOptions are unset")
+	}
+
+	ve := &ValidationError{Options: "ReviewsOptions"}
+	if ro.ID == "" {
+		ve.add("ID", "is not set")
+	}
+	if ro.Locale != nil && ValidateLocale(*ro.Locale) != nil {
+		ve.add("Locale", "is not a supported locale: %s", *ro.Locale)
+	}
+	if ro.Limit != nil && (*ro.Limit < 0 || *ro.Limit > maxReviewsLimit) {
+		ve.add("Limit", "must be between 0 and %d: %d", maxReviewsLimit, *ro.Limit)
+	}
+	if ro.Offset != nil && *ro.Offset < 0 {
+		ve.add("Offset", "must not be negative: %d", *ro.Offset)
+	}
+	return ve.errorOrNil()
+}
+
+// URLValues returns ReviewsOptions as url.Values.
+func (ro *ReviewsOptions) URLValues() url.Values {
+	if ro == nil {
+		return nil
+	}
+
+	vals := url.Values{}
+	if ro.Locale != nil {
+		vals.Add("locale", *ro.Locale)
+	}
+	if ro.Limit != nil {
+		vals.Add("limit", IntString(*ro.Limit))
+	}
+	if ro.Offset != nil {
+		vals.Add("offset", IntString(*ro.Offset))
+	}
+	return vals
+}
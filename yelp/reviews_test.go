@@ -0,0 +1,81 @@
+package yelp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestReviews(t *testing.T) {
+	ctx := context.Background()
+	mocks := &testMocks{}
+	options := &ReviewsOptions{}
+	t.Run("invalid options", func(t *testing.T) {
+		client := newTestClient(nil, "API_KEY", mocks)
+		_, err := client.Reviews(ctx, options)
+		assert(t, err != nil, "Expected an error when options are invalid")
+	})
+
+	t.Run("failed request", func(t *testing.T) {
+		options.ID = "snorlax"
+		mocks.mockRequest(http.MethodGet, reviewsPath(options), http.StatusInternalServerError, errors.New("Internal server error"))
+		client := newTestClient(mocks.server.Client(), "API_KEY", mocks)
+
+		_, err := client.Reviews(ctx, options)
+		assert(t, err != nil, "Expected an error when request fails")
+	})
+
+	t.Run("successful request", func(t *testing.T) {
+		expected := ReviewsResults{
+			Total:   1,
+			Reviews: []Review{{ID: "review_0", Rating: 5, Text: "Zzz", User: User{ID: "user_0", Name: "Ash K."}}},
+		}
+		options.ID = "snorlax-route-12"
+		options.Limit = Int64Pointer(1)
+		mocks.mockRequest(http.MethodGet, reviewsPath(options), http.StatusOK, expected)
+		client := newTestClient(mocks.server.Client(), "API_KEY", mocks)
+
+		results, err := client.Reviews(ctx, options)
+		assert(t, err == nil, "Expected no error (%v) when request succeeds", err)
+		assert(t, reflect.DeepEqual(*results, expected), "Results (%v) did not match expected (%v)", results, expected)
+	})
+}
+
+func TestReviewsOptions(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		var options *ReviewsOptions
+		t.Run("ReviewsOptions are unset", func(t *testing.T) {
+			assert(t, options.Validate() != nil, "Empty options should error")
+		})
+
+		t.Run("ID is unset", func(t *testing.T) {
+			options = &ReviewsOptions{}
+			assert(t, options.Validate() != nil, "Unset ID should error")
+		})
+
+		t.Run("Limit is out of range", func(t *testing.T) {
+			options = &ReviewsOptions{ID: "snorlax", Limit: Int64Pointer(maxReviewsLimit + 1)}
+			assert(t, options.Validate() != nil, "Limit over the max should error")
+		})
+
+		t.Run("All options set properly", func(t *testing.T) {
+			options = &ReviewsOptions{
+				ID:     "snorlax",
+				Locale: StringPointer("en_US"),
+				Limit:  Int64Pointer(20),
+				Offset: Int64Pointer(20),
+			}
+			assert(t, options.Validate() == nil, "Valid options should not error")
+		})
+	})
+
+	t.Run("URLValues", func(t *testing.T) {
+		options := &ReviewsOptions{ID: "snorlax", Limit: Int64Pointer(3)}
+		vals := options.URLValues()
+		assert(t, vals.Get("limit") == "3", "Limit: Expected \"%s\" to equal 3", vals.Get("limit"))
+		assert(t, vals.Get("id") == "", "ID should be part of the path, not the query")
+		assert(t, reviewsPath(&ReviewsOptions{ID: "café/1"}) == "/v3/businesses/caf%C3%A9%2F1/reviews?", "Expected the ID to be escaped: %s", reviewsPath(&ReviewsOptions{ID: "café/1"}))
+	})
+}
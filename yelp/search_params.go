@@ -0,0 +1,160 @@
+package yelp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SortBy is the order in which the Business Search API returns results.
+type SortBy string
+
+// The sort orders supported by the Business Search API.
+const (
+	SortByBestMatch   SortBy = "best_match"
+	SortByRating      SortBy = "rating"
+	SortByReviewCount SortBy = "review_count"
+	SortByDistance    SortBy = "distance"
+)
+
+// SortByPointer returns a pointer to the input.
+func SortByPointer(s SortBy) *SortBy {
+	return &s
+}
+
+// Validate returns an error if s is not a supported sort order.
+func (s SortBy) Validate() error {
+	switch s {
+	case SortByBestMatch, SortByRating, SortByReviewCount, SortByDistance:
+		return nil
+	default:
+		return fmt.Errorf("Invalid sort by provided: %s", s)
+	}
+}
+
+// PriceLevel is a Yelp price level, from 1 ($) to 4 ($$$$).
+type PriceLevel int64
+
+// The price levels supported by the Yelp API.
+const (
+	PriceInexpensive  PriceLevel = 1
+	PriceModerate     PriceLevel = 2
+	PricePricey       PriceLevel = 3
+	PriceUltraHighEnd PriceLevel = 4
+	minPriceLevel                = PriceInexpensive
+	maxPriceLevel                = PriceUltraHighEnd
+)
+
+// Validate returns an error if p is not between 1 and 4.
+func (p PriceLevel) Validate() error {
+	if p < minPriceLevel || p > maxPriceLevel {
+		return fmt.Errorf("Invalid price level provided: %d", p)
+	}
+	return nil
+}
+
+// PriceLevels is a set of price levels to filter by.
+type PriceLevels []PriceLevel
+
+// Validate returns an error for the first invalid price level.
+func (ps PriceLevels) Validate() error {
+	for _, p := range ps {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Matches returns whether a Business `Price`, ie. "$$" or "€€", is any of the
+// price levels. Every price matches empty price levels.
+func (ps PriceLevels) Matches(price string) bool {
+	if len(ps) == 0 {
+		return true
+	}
+	level, err := ParsePrice(price)
+	if err != nil {
+		return false
+	}
+	for _, p := range ps {
+		if p == level {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the price levels sorted and deduplicated as a comma separated
+// list, ie. "1,2,3".
+func (ps PriceLevels) String() string {
+	seen := map[PriceLevel]struct{}{}
+	levels := make([]int, 0, len(ps))
+	for _, p := range ps {
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		levels = append(levels, int(p))
+	}
+	sort.Ints(levels)
+
+	strs := make([]string, len(levels))
+	for i, l := range levels {
+		strs[i] = IntString(int64(l))
+	}
+	return strings.Join(strs, ",")
+}
+
+// Attribute is an additional filter for the Business Search API.
+type Attribute string
+
+// The attributes supported by the Business Search API.
+const (
+	AttributeHotAndNew              Attribute = "hot_and_new"
+	AttributeRequestAQuote          Attribute = "request_a_quote"
+	AttributeReservation            Attribute = "reservation"
+	AttributeWaitlistReservation    Attribute = "waitlist_reservation"
+	AttributeDeals                  Attribute = "deals"
+	AttributeGenderNeutralRestrooms Attribute = "gender_neutral_restrooms"
+	AttributeOpenToAll              Attribute = "open_to_all"
+	AttributeWheelchairAccessible   Attribute = "wheelchair_accessible"
+)
+
+// Validate returns an error if a is not a supported attribute.
+func (a Attribute) Validate() error {
+	switch a {
+	case AttributeHotAndNew, AttributeRequestAQuote, AttributeReservation,
+		AttributeWaitlistReservation, AttributeDeals, AttributeGenderNeutralRestrooms,
+		AttributeOpenToAll, AttributeWheelchairAccessible:
+		return nil
+	default:
+		return fmt.Errorf("Invalid attribute provided: %s", a)
+	}
+}
+
+// Attributes is a set of attributes to filter by.
+type Attributes []Attribute
+
+// Validate returns an error for the first invalid attribute.
+func (as Attributes) Validate() error {
+	for _, a := range as {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String returns the attributes deduplicated as a comma separated list.
+func (as Attributes) String() string {
+	seen := map[Attribute]struct{}{}
+	strs := make([]string, 0, len(as))
+	for _, a := range as {
+		if _, ok := seen[a]; ok {
+			continue
+		}
+		seen[a] = struct{}{}
+		strs = append(strs, string(a))
+	}
+	return strings.Join(strs, ",")
+}
@@ -0,0 +1,45 @@
+package yelp
+
+import "testing"
+
+func TestSortBy(t *testing.T) {
+	t.Run("Valid sort by", func(t *testing.T) {
+		assert(t, SortByReviewCount.Validate() == nil, "Valid sort by should not error")
+	})
+
+	t.Run("Invalid sort by", func(t *testing.T) {
+		assert(t, SortBy("bsort_by").Validate() != nil, "Invalid sort by should error")
+	})
+}
+
+func TestPriceLevels(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		assert(t, PriceLevels{PriceInexpensive, PriceUltraHighEnd}.Validate() == nil, "Valid price levels should not error")
+		assert(t, PriceLevels{0}.Validate() != nil, "Price level 0 should error")
+		assert(t, PriceLevels{5}.Validate() != nil, "Price level 5 should error")
+	})
+
+	t.Run("String", func(t *testing.T) {
+		s := PriceLevels{PricePricey, PriceInexpensive, PriceModerate, PricePricey}.String()
+		assert(t, s == "1,2,3", "Expected \"%s\" to equal \"1,2,3\"", s)
+	})
+
+	t.Run("Matches", func(t *testing.T) {
+		ps := PriceLevels{PriceModerate}
+		assert(t, ps.Matches("$$") && ps.Matches("€€") && ps.Matches("￥￥"), "Expected two currency symbols to match PriceModerate")
+		assert(t, !ps.Matches("€") && !ps.Matches("$$$") && !ps.Matches("$€") && !ps.Matches(""), "Expected other prices to not match PriceModerate")
+		assert(t, PriceLevels{}.Matches("") && PriceLevels(nil).Matches("€€€€"), "Expected every price to match empty price levels")
+	})
+}
+
+func TestAttributes(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		assert(t, Attributes{AttributeOpenToAll, AttributeWheelchairAccessible}.Validate() == nil, "Valid attributes should not error")
+		assert(t, Attributes{"gender_neutral_restrictrooms"}.Validate() != nil, "Invalid attribute should error")
+	})
+
+	t.Run("String", func(t *testing.T) {
+		s := Attributes{AttributeReservation, AttributeDeals, AttributeReservation}.String()
+		assert(t, s == "reservation,deals", "Expected \"%s\" to equal \"reservation,deals\"", s)
+	})
+}
@@ -0,0 +1,242 @@
+package yelp
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// webPricePrefix prefixes the price levels in the "attrs" parameter of
+// yelp.com search URLs, ie. "RestaurantsPriceRange2.2".
+const webPricePrefix = "RestaurantsPriceRange2."
+
+// webSorts are the "sortby" values of yelp.com search URLs.
+var webSorts = map[string]SortBy{
+	"recommended":  SortByBestMatch,
+	"rating":       SortByRating,
+	"review_count": SortByReviewCount,
+	"distance":     SortByDistance,
+}
+
+// webAttributes are the "attrs" values of yelp.com search URLs that match an
+// Attribute of the Business Search API.
+var webAttributes = map[string]Attribute{
+	"GenderNeutralRestrooms":  AttributeGenderNeutralRestrooms,
+	"HotAndNew":               AttributeHotAndNew,
+	"OpenToAll":               AttributeOpenToAll,
+	"RequestAQuote":           AttributeRequestAQuote,
+	"RestaurantsReservations": AttributeReservation,
+	"WaitlistReservation":     AttributeWaitlistReservation,
+	"WheelchairAccessible":    AttributeWheelchairAccessible,
+	"deals":                   AttributeDeals,
+}
+
+// ParseBusinessURL returns the GetBusinessOptions of a yelp.com business URL,
+// ie. "https://www.yelp.com/biz/some-alias-san-francisco", using the business
+// alias as its ID. The names of the query parameters, which have no equivalent
+// option, are returned as unsupported.
+func ParseBusinessURL(rawURL string) (*GetBusinessOptions, []string, error) {
+	u, err := parseWebURL(rawURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	alias := strings.TrimPrefix(strings.TrimSuffix(u.Path, "/"), "/biz/")
+	if alias == u.Path || alias == "" || strings.Contains(alias, "/") {
+		return nil, nil, fmt.Errorf("Invalid business URL provided: %s", rawURL)
+	}
+
+	var unsupported []string
+	for name := range u.Query() {
+		unsupported = append(unsupported, name)
+	}
+	sort.Strings(unsupported)
+	return &GetBusinessOptions{ID: alias}, unsupported, nil
+}
+
+// ParseSearchURL returns the BusinessSearchOptions of a yelp.com search URL,
+// ie. "https://www.yelp.com/search?find_desc=pizza&find_loc=Boston". The term,
+// location, map area ("l=g:..."), categories ("cflt"), price levels and
+// attributes ("attrs"), sort order ("sortby") and offset ("start") are
+// supported. Parameters and "attrs" values without an equivalent option, ie.
+// "attrs=BusinessAcceptsCreditCards", are returned as unsupported. The options
+// are not validated, as search URLs may leave out the location.
+func ParseSearchURL(rawURL string) (*BusinessSearchOptions, []string, error) {
+	u, err := parseWebURL(rawURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	if strings.TrimSuffix(u.Path, "/") != "/search" {
+		return nil, nil, fmt.Errorf("Invalid search URL provided: %s", rawURL)
+	}
+
+	bso := &BusinessSearchOptions{}
+	var unsupported []string
+	for name, values := range u.Query() {
+		value := values[len(values)-1]
+		switch name {
+		case "find_desc":
+			if value != "" {
+				bso.Term = StringPointer(value)
+			}
+		case "find_loc":
+			if value != "" {
+				bso.Location = StringPointer(value)
+			}
+		case "l":
+			box, err := parseWebMapArea(value)
+			if err != nil {
+				return nil, nil, err
+			}
+			if box == nil {
+				unsupported = append(unsupported, name)
+				continue
+			}
+			center, radius := box.SearchArea()
+			bso.Coordinates = &center
+			bso.Radius = Int64Pointer(radius)
+			if radius > maxSearchRadius {
+				bso.Radius = Int64Pointer(maxSearchRadius)
+			}
+		case "cflt":
+			bso.Categories = splitNonEmpty(value)
+		case "attrs":
+			for _, attr := range splitNonEmpty(value) {
+				if a, ok := webAttributes[attr]; ok {
+					bso.Attributes = append(bso.Attributes, a)
+					continue
+				}
+				if strings.HasPrefix(attr, webPricePrefix) {
+					if level, err := strconv.ParseInt(strings.TrimPrefix(attr, webPricePrefix), 10, 64); err == nil {
+						bso.Price = append(bso.Price, PriceLevel(level))
+						continue
+					}
+				}
+				unsupported = append(unsupported, name+"="+attr)
+			}
+		case "sortby":
+			sortBy, ok := webSorts[value]
+			if !ok {
+				unsupported = append(unsupported, name+"="+value)
+				continue
+			}
+			bso.SortBy = SortByPointer(sortBy)
+		case "start":
+			offset, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("Invalid start provided: %s", value)
+			}
+			bso.Offset = Int64Pointer(offset)
+		default:
+			unsupported = append(unsupported, name)
+		}
+	}
+
+	// the map area is used by yelp.com instead of the location when both are set
+	if bso.Coordinates != nil {
+		bso.Location = nil
+	}
+	sort.Strings(unsupported)
+	return bso, unsupported, nil
+}
+
+// parseWebURL parses a URL and checks that it is on a yelp.com domain, including
+// the country domains such as yelp.co.uk and yelp.de.
+func parseWebURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || !isWebHost(u.Hostname()) {
+		return nil, fmt.Errorf("Invalid yelp URL provided: %s", rawURL)
+	}
+	return u, nil
+}
+
+// webDomains are the domains of the Yelp websites.
+var webDomains = map[string]struct{}{
+	"yelp.at":     struct{}{},
+	"yelp.be":     struct{}{},
+	"yelp.ca":     struct{}{},
+	"yelp.ch":     struct{}{},
+	"yelp.cl":     struct{}{},
+	"yelp.co.jp":  struct{}{},
+	"yelp.co.nz":  struct{}{},
+	"yelp.co.uk":  struct{}{},
+	"yelp.com":    struct{}{},
+	"yelp.com.ar": struct{}{},
+	"yelp.com.au": struct{}{},
+	"yelp.com.br": struct{}{},
+	"yelp.com.hk": struct{}{},
+	"yelp.com.mx": struct{}{},
+	"yelp.com.ph": struct{}{},
+	"yelp.com.sg": struct{}{},
+	"yelp.com.tr": struct{}{},
+	"yelp.com.tw": struct{}{},
+	"yelp.cz":     struct{}{},
+	"yelp.de":     struct{}{},
+	"yelp.dk":     struct{}{},
+	"yelp.es":     struct{}{},
+	"yelp.fi":     struct{}{},
+	"yelp.fr":     struct{}{},
+	"yelp.ie":     struct{}{},
+	"yelp.it":     struct{}{},
+	"yelp.my":     struct{}{},
+	"yelp.nl":     struct{}{},
+	"yelp.no":     struct{}{},
+	"yelp.pl":     struct{}{},
+	"yelp.pt":     struct{}{},
+	"yelp.se":     struct{}{},
+}
+
+// isWebHost returns whether host is one of the webDomains or a subdomain of
+// one, ie. "www.yelp.com", "m.yelp.com" or "fr.yelp.ca".
+func isWebHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	for domain := range webDomains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseWebMapArea parses the "l" parameter of a yelp.com search URL. Map areas,
+// ie. "g:-122.44,37.77,-122.38,37.80", are returned as a BoundingBox. Other
+// kinds of areas, such as neighborhoods, return nil.
+func parseWebMapArea(l string) (*BoundingBox, error) {
+	if !strings.HasPrefix(l, "g:") {
+		return nil, nil
+	}
+	parts := strings.Split(strings.TrimPrefix(l, "g:"), ",")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("Invalid map area provided: %s", l)
+	}
+	var coords [4]float64
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid map area provided: %s", l)
+		}
+		coords[i] = f
+	}
+
+	// the corners are given as longitude,latitude pairs
+	return &BoundingBox{
+		SouthWest: Coordinates{Latitude: math.Min(coords[1], coords[3]), Longitude: math.Min(coords[0], coords[2])},
+		NorthEast: Coordinates{Latitude: math.Max(coords[1], coords[3]), Longitude: math.Max(coords[0], coords[2])},
+	}, nil
+}
+
+// splitNonEmpty splits a comma separated list, leaving out empty values.
+func splitNonEmpty(s string) []string {
+	var values []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,71 @@
+package yelp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseBusinessURL(t *testing.T) {
+	gbo, unsupported, err := ParseBusinessURL("https://www.yelp.com/biz/celadon-department-store-celadon-city?osq=pokeballs&hrid=abc")
+	assert(t, err == nil && gbo.ID == "celadon-department-store-celadon-city", "Unexpected options %+v (%v)", gbo, err)
+	assert(t, reflect.DeepEqual(unsupported, []string{"hrid", "osq"}), "Unexpected unsupported parameters %v", unsupported)
+
+	gbo, _, err = ParseBusinessURL("https://m.yelp.co.uk/biz/caf%C3%A9-saffron/")
+	assert(t, err == nil && gbo.ID == "café-saffron", "Expected the alias to be unescaped, got %+v (%v)", gbo, err)
+
+	for _, host := range []string{"yelp.com", "fr.yelp.ca", "WWW.YELP.DE", "www.yelp.com.au"} {
+		_, _, err = ParseBusinessURL("https://" + host + "/biz/alias")
+		assert(t, err == nil, "Expected %s to be a yelp host: %v", host, err)
+	}
+
+	for _, rawURL := range []string{
+		"https://www.yelp.com/search?find_desc=pizza",
+		"https://www.yelp.com/biz/",
+		"https://www.yelp.com/biz/alias/photos",
+		"https://www.notyelp.com/biz/alias",
+		"https://yelp.evil/biz/alias",
+		"https://www.yelp.com.attacker/biz/alias",
+		"https://www.yelp.co.evil/biz/alias",
+		"https://yelp.com.evil.com/biz/alias",
+		"ftp://www.yelp.com/biz/alias",
+	} {
+		_, _, err := ParseBusinessURL(rawURL)
+		assert(t, err != nil, "Expected %s to error", rawURL)
+	}
+}
+
+func TestParseSearchURL(t *testing.T) {
+	t.Run("Supported parameters", func(t *testing.T) {
+		bso, unsupported, err := ParseSearchURL("https://www.yelp.com/search?find_desc=pizza&find_loc=Boston%2C+MA&cflt=pizza,italian" +
+			"&attrs=RestaurantsPriceRange2.1,RestaurantsPriceRange2.2,WheelchairAccessible,BusinessAcceptsCreditCards&sortby=rating&start=10&ns=1")
+		assert(t, err == nil, "Expected the URL to parse (%v)", err)
+		assert(t, StringValue(bso.Term) == "pizza" && StringValue(bso.Location) == "Boston, MA", "Unexpected term and location %+v", bso)
+		assert(t, reflect.DeepEqual(bso.Categories, []string{"pizza", "italian"}), "Unexpected categories %v", bso.Categories)
+		assert(t, bso.Price.String() == "1,2", "Unexpected price %v", bso.Price)
+		assert(t, reflect.DeepEqual(bso.Attributes, Attributes{AttributeWheelchairAccessible}), "Unexpected attributes %v", bso.Attributes)
+		assert(t, *bso.SortBy == SortByRating && Int64Value(bso.Offset) == 10, "Unexpected sort and offset %+v", bso)
+		assert(t, reflect.DeepEqual(unsupported, []string{"attrs=BusinessAcceptsCreditCards", "ns"}), "Unexpected unsupported parameters %v", unsupported)
+		assert(t, bso.Validate() == nil, "Expected valid options (%v)", bso.Validate())
+	})
+
+	t.Run("Map area", func(t *testing.T) {
+		bso, _, err := ParseSearchURL("https://www.yelp.com/search?find_loc=San+Francisco&l=g:-122.44,37.77,-122.40,37.80")
+		assert(t, err == nil && bso.Location == nil && bso.Coordinates != nil, "Expected coordinates instead of a location (%v)", err)
+		assert(t, bso.Coordinates.Latitude > 37.78 && bso.Coordinates.Latitude < 37.79 && Int64Value(bso.Radius) > 2000, "Unexpected area %+v %d", bso.Coordinates, Int64Value(bso.Radius))
+
+		bso, unsupported, err := ParseSearchURL("https://www.yelp.com/search?find_loc=San+Francisco&l=p:CA:San_Francisco::Mission")
+		assert(t, err == nil && StringValue(bso.Location) == "San Francisco" && reflect.DeepEqual(unsupported, []string{"l"}), "Expected neighborhoods to be unsupported")
+	})
+
+	t.Run("Invalid URLs", func(t *testing.T) {
+		for _, rawURL := range []string{
+			"https://www.yelp.com/biz/alias",
+			"https://www.yelp.com/search?start=ten",
+			"https://www.yelp.com/search?l=g:1,2,3",
+			"https://example.com/search?find_desc=pizza",
+		} {
+			_, _, err := ParseSearchURL(rawURL)
+			assert(t, err != nil, "Expected %s to error", rawURL)
+		}
+	})
+}
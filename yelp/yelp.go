@@ -18,38 +18,109 @@ type Client interface {
 	GetBusiness(context.Context, *GetBusinessOptions) (*Business, error)
 }
 
-// client implements the Client interface.
+// ExtendedClient is a Client which can also make the Reviews, Business Match,
+// Phone Search, Autocomplete and Categories requests. The Client returned by
+// New is an ExtendedClient.
+type ExtendedClient interface {
+	Client
+	Reviews(context.Context, *ReviewsOptions) (*ReviewsResults, error)
+	BusinessMatch(context.Context, *BusinessMatchOptions) (*BusinessMatchResults, error)
+	PhoneSearch(context.Context, *PhoneSearchOptions) (*BusinessSearchResults, error)
+	Autocomplete(context.Context, *AutocompleteOptions) (*AutocompleteResults, error)
+	Categories(context.Context, *CategoriesOptions) (*CategoriesResults, error)
+	GetCategory(context.Context, *GetCategoryOptions) (*CategoryDetails, error)
+}
+
+// client implements the ExtendedClient interface.
 type client struct {
 	*http.Client
-	apiKey string
-	host   string
+	apiKey  string
+	host    string
+	limiter RateLimiter
+	keys    *KeyPool
+	batcher *graphQLBatcher
+}
+
+// Option configures a client returned by New.
+type Option func(*client)
+
+// WithRateLimiter makes the client wait on l before every request.
+func WithRateLimiter(l RateLimiter) Option {
+	return func(c *client) {
+		c.limiter = l
+	}
 }
 
 // New returns a new Yelp client. The default host is https://api.yelp.com.
-func New(c *http.Client, apiKey string) Client {
-	return &client{
+func New(c *http.Client, apiKey string, opts ...Option) Client {
+	cl := &client{
 		Client: c,
 		apiKey: apiKey,
 		host:   "https://api.yelp.com",
 	}
+	for _, opt := range opts {
+		opt(cl)
+	}
+	return cl
 }
 
 // authedDo sets the Authorization header to the api key provided to the client .
-// The response is decoded into v.
+// The response is decoded into v. If the client has a rate limiter, authedDo
+// waits on it before making the request. If the client has a key pool, requests
+// without a body that are rejected by a key are retried with another key. The
+// api key and locale set on ctx by WithAPIKey and WithLocale take precedence.
 func (c *client) authedDo(ctx context.Context, method string, path string, body io.Reader, headers map[string]string, v interface{}) (*http.Response, error) {
-	req, err := http.NewRequest(method, fmt.Sprintf("%s%s", c.host, path), body)
+	path, err := contextLocalePath(ctx, path)
 	if err != nil {
 		return nil, err
 	}
 
+	attempts := 1
+	if _, ok := APIKeyFromContext(ctx); !ok && c.keys != nil && body == nil {
+		attempts = c.keys.Len()
+	}
+	for attempt := 1; ; attempt++ {
+		resp, rejected, err := c.do(ctx, method, path, body, headers, v)
+		if !rejected || attempt >= attempts {
+			return resp, err
+		}
+	}
+}
+
+// do makes a single request for authedDo, and returns whether the api key used
+// was rejected.
+func (c *client) do(ctx context.Context, method string, path string, body io.Reader, headers map[string]string, v interface{}) (*http.Response, bool, error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, false, err
+		}
+	}
+
+	apiKey, fromContext := APIKeyFromContext(ctx)
+	usePool := !fromContext && c.keys != nil
+	if !fromContext {
+		apiKey = c.apiKey
+	}
+	if usePool {
+		var err error
+		if apiKey, err = c.keys.next(); err != nil {
+			return nil, false, err
+		}
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("%s%s", c.host, path), body)
+	if err != nil {
+		return nil, false, err
+	}
+
 	for key, val := range headers {
 		req.Header.Set(key, val)
 	}
-	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	req.Header.Set("Authorization", "Bearer "+apiKey)
 
 	resp, err := c.Do(req.WithContext(ctx))
 	if err != nil {
-		return resp, err
+		return resp, false, err
 	}
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
@@ -57,17 +128,22 @@ func (c *client) authedDo(ctx context.Context, method string, path string, body
 		}
 	}()
 
+	var rejected bool
+	if usePool {
+		rejected = c.keys.record(apiKey, resp)
+	}
+
 	// return an error for non-2xx status codes
 	if resp.StatusCode >= 300 {
 		errString := fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
 		if respBytes, err := ioutil.ReadAll(resp.Body); err == nil {
-			return nil, fmt.Errorf("%s: %s", errString, string(respBytes))
+			return nil, rejected, fmt.Errorf("%s: %s", errString, string(respBytes))
 		}
-		return nil, errors.New(errString)
+		return nil, rejected, errors.New(errString)
 	}
 
 	err = json.NewDecoder(resp.Body).Decode(v)
-	return resp, err
+	return resp, false, err
 }
 
 // postForm makes a POST request with form values and decodes the response body
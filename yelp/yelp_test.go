@@ -29,7 +29,7 @@ func (m *testMocks) mockRequest(method, path string, status int, response interf
 	}))
 }
 
-func newTestClient(c *http.Client, apiKey string, m *testMocks) Client {
+func newTestClient(c *http.Client, apiKey string, m *testMocks) ExtendedClient {
 	var host string
 	if m != nil && m.server != nil {
 		host = m.server.URL